@@ -12,6 +12,12 @@ var (
 	ErrRegionNotFound = errors.New("region not found")
 	// ErrProviderNotFound is returned when a provider name is not recognized.
 	ErrProviderNotFound = errors.New("provider not found")
+	// ErrZoneNotFound is returned when a zone name is not recognized.
+	ErrZoneNotFound = errors.New("zone not found")
+	// ErrEndpointNotFound is returned when a region has no endpoint for a service or variant.
+	ErrEndpointNotFound = errors.New("endpoint not found")
+	// ErrCityNotFound is returned when a city is neither a region city nor in the embedded world-cities dataset.
+	ErrCityNotFound = errors.New("city not found")
 )
 
 // Question-style API functions that read like natural English
@@ -21,9 +27,21 @@ var (
 func Is(code Code) RegionQuery {
 	regions, exists := regionRegistry[code]
 	if !exists {
-		return RegionQuery{regions: []Region{}}
+		return RegionQuery{code: code, regions: []Region{}}
 	}
-	return RegionQuery{regions: regions}
+	return RegionQuery{code: code, regions: regions}
+}
+
+// lookup returns the region of a provider with the code, probing the
+// registry without building a NotFoundError for internal code that expects
+// misses.
+func lookup(provider string, code Code) (Region, bool) {
+	for _, region := range regionRegistry[code] {
+		if strings.EqualFold(region.Provider, provider) {
+			return region, true
+		}
+	}
+	return Region{}, false
 }
 
 // MustIs is like Is().First() but panics on error. Use when you're certain the region exists.
@@ -36,67 +54,82 @@ func MustIs(code Code) Region {
 	return region
 }
 
+// ByID answers "where is {provider}/{code}?" - returns the single region for a provider-qualified ID.
+func ByID(id RegionID) (Region, error) {
+	return Is(id.Code()).OnProvider(id.Provider())
+}
+
 // Are answers "where are {codes}?" - returns information about multiple regions.
 func Are(codes ...Code) (Set, error) {
 	regions := make(Set, 0, len(codes))
-	var notFound []Code
+	var notFound []error
 
 	for _, code := range codes {
 		if regionList, exists := regionRegistry[code]; exists {
 			// Add all regions for this code to the result
 			regions = append(regions, regionList...)
 		} else {
-			notFound = append(notFound, code)
+			notFound = append(notFound, newNotFoundError(code, ""))
 		}
 	}
 
 	if len(notFound) > 0 {
-		return regions, fmt.Errorf("%w: %v", ErrRegionNotFound, notFound)
+		return regions, errors.Join(notFound...)
 	}
 
 	return regions, nil
 }
 
+// Catalog-wide queries below only cover the commercial cloud. Regions in isolated
+// partitions are still found by Is, Are and ByID, and can be listed with InPartition.
+
 // InCountry answers "where in country {name}?" - returns all regions in a country.
 func InCountry(name string) Set {
-	return allRegions().ByCountry(name)
+	return commercialRegions().ByCountry(name)
 }
 
 // InCity answers "where in city {name}?" - returns all regions in a city.
 func InCity(name string) Set {
-	return allRegions().ByCity(name)
+	return commercialRegions().ByCity(name)
 }
 
 // InContinent answers "where in continent {name}?" - returns all regions in a continent.
 func InContinent(name string) Set {
-	return allRegions().ByContinent(name)
+	return commercialRegions().ByContinent(name)
 }
 
 // OnProvider answers "where by provider {name}?" - returns all regions from a provider.
 func OnProvider(name string) Set {
-	return allRegions().OnProvider(name)
+	return commercialRegions().OnProvider(name)
+}
+
+// InPartition answers "where in partition {p}?" - returns all regions in the given partitions.
+func InPartition(partitions ...Partition) Set {
+	return allRegions().InPartition(partitions...)
 }
 
 // Near answers "where near {location} within {radius}km?" - requires coordinates.
 func Near(lat, lng float64, radiusKm float64) Set {
-	return allRegions().Near(lat, lng, radiusKm)
+	return commercialRegions().Near(lat, lng, radiusKm)
 }
 
 // ActiveRegions answers "where active?" - returns all currently active regions.
 func ActiveRegions() Set {
-	return allRegions().ActiveOnly()
+	return commercialRegions().ActiveOnly()
 }
 
 // PreviewRegions answers "where preview?" - returns all preview/beta regions.
 func PreviewRegions() Set {
-	return allRegions().Filter(func(r Region) bool {
+	return commercialRegions().Filter(func(r Region) bool {
 		return r.Status == Preview
 	})
 }
 
 // DeprecatedRegions answers "where deprecated?" - returns all deprecated regions.
+// Like the other status queries it covers commercial regions; use
+// NewQuery().DeprecatedOnly() to include isolated partitions.
 func DeprecatedRegions() Set {
-	return allRegions().Filter(func(r Region) bool {
+	return commercialRegions().Filter(func(r Region) bool {
 		return r.Status == Deprecated
 	})
 }
@@ -201,61 +234,85 @@ func Continents() []string {
 }
 
 // Distance calculates the distance between two regions in kilometers.
-// If multiple regions exist for a code, uses the first region.
+// If multiple regions exist for a code, uses the first region; use
+// DistanceBetween for codes shared by several providers.
 func Distance(from, to Code) (float64, error) {
-	fromQuery := Is(from)
-	fromRegion, err := fromQuery.First()
+	fromRegion, err := Is(from).First()
 	if err != nil {
 		return 0, fmt.Errorf("source region %w", err)
 	}
-
-	toQuery := Is(to)
-	toRegion, err := toQuery.First()
+	toRegion, err := Is(to).First()
 	if err != nil {
 		return 0, fmt.Errorf("destination region %w", err)
 	}
+	return fromRegion.Distance(toRegion), nil
+}
 
+// DistanceBetween calculates the distance in kilometers between two regions
+// identified by provider, e.g. "aws/ap-southeast-1" and "huawei/ap-southeast-1".
+func DistanceBetween(from, to RegionID) (float64, error) {
+	fromRegion, err := ByID(from)
+	if err != nil {
+		return 0, fmt.Errorf("source region %w", err)
+	}
+	toRegion, err := ByID(to)
+	if err != nil {
+		return 0, fmt.Errorf("destination region %w", err)
+	}
 	return fromRegion.Distance(toRegion), nil
 }
 
-// Closest finds the closest region to the specified region.
-// If multiple regions exist for a code, uses the first region.
+// Closest finds the closest region to the specified region, of any provider.
+// If multiple regions exist for a code, uses the first region, and no region
+// with the code is a candidate; use ClosestTo for codes shared by several
+// providers.
 func Closest(to Code) (Region, error) {
-	targetQuery := Is(to)
-	target, err := targetQuery.First()
+	target, err := Is(to).First()
 	if err != nil {
 		return Region{}, err
 	}
+	return closestTo(target, func(r Region) bool { return r.Code == to })
+}
 
+// ClosestTo finds the closest region, of any provider, to the region
+// identified by provider and code. Unlike Closest, other providers' regions
+// sharing the code are candidates too.
+func ClosestTo(id RegionID) (Region, error) {
+	target, err := ByID(id)
+	if err != nil {
+		return Region{}, err
+	}
+	return closestTo(target, func(r Region) bool { return r.ID() == target.ID() })
+}
+
+// closestTo returns the catalog region nearest to target among those not
+// skipped.
+func closestTo(target Region, skip func(Region) bool) (Region, error) {
 	var closest Region
 	minDistance := math.MaxFloat64
-
-	for _, regionList := range regionRegistry {
-		for _, region := range regionList {
-			if region.Code == to {
-				continue // Skip the target region itself
-			}
-
-			distance := target.Distance(region)
-			if distance < minDistance {
-				minDistance = distance
-				closest = region
-			}
+	for _, region := range catalog {
+		if skip(region) {
+			continue
+		}
+		if distance := target.Distance(region); distance < minDistance {
+			minDistance = distance
+			closest = region
 		}
 	}
-
 	if closest.Code == "" {
 		return Region{}, errors.New("no other regions found")
 	}
-
 	return closest, nil
 }
 
-// allRegions returns all regions as a Set.
+// allRegions returns all regions as a Set, in catalog order.
 func allRegions() Set {
-	regions := make(Set, 0)
-	for _, regionList := range regionRegistry {
-		regions = append(regions, regionList...)
-	}
+	regions := make(Set, len(catalog))
+	copy(regions, catalog)
 	return regions
 }
+
+// commercialRegions returns all regions outside isolated partitions as a Set.
+func commercialRegions() Set {
+	return allRegions().CommercialOnly()
+}
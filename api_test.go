@@ -124,6 +124,107 @@ func TestAre(t *testing.T) {
 	}
 }
 
+func TestSharedCodeDisambiguation(t *testing.T) {
+	tests := []struct {
+		provider string
+		city     string
+	}{
+		{ProviderAWS, "Singapore"},
+		{ProviderAlibaba, "Singapore"},
+		{ProviderHuawei, "Hong Kong"},
+	}
+
+	regions, err := Are("ap-southeast-1")
+	if err != nil {
+		t.Fatalf("Are() failed: %v", err)
+	}
+	if len(regions) < len(tests) {
+		t.Errorf("Expected at least %d regions for ap-southeast-1, got %d", len(tests), len(regions))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			region, err := Is("ap-southeast-1").OnProvider(tt.provider)
+			if err != nil {
+				t.Fatalf("Is().OnProvider() failed: %v", err)
+			}
+			if region.City != tt.city {
+				t.Errorf("Expected %s ap-southeast-1 in %s, got %s", tt.provider, tt.city, region.City)
+			}
+
+			byID, err := ByID(NewRegionID(tt.provider, "ap-southeast-1"))
+			if err != nil {
+				t.Fatalf("ByID() failed: %v", err)
+			}
+			if byID.ID() != region.ID() {
+				t.Errorf("ByID() = %v, want %v", byID.ID(), region.ID())
+			}
+		})
+	}
+
+	if _, err := ByID("tencent/ap-southeast-1"); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("ByID() should return ErrRegionNotFound, got %v", err)
+	}
+
+	if set := On.Tencent(); len(set) == 0 {
+		t.Error("On.Tencent() returned no regions")
+	}
+}
+
+func TestPartitions(t *testing.T) {
+	region, err := Is(Azure.USGovVirginia).OnAzure()
+	if err != nil {
+		t.Fatalf("Is(usgovvirginia) failed: %v", err)
+	}
+	if region.Partition != PartitionAzureGovernment || !region.IsIsolated() {
+		t.Errorf("usgovvirginia should be in %v, got %v", PartitionAzureGovernment, region.Partition)
+	}
+
+	commercial, err := Is(AWS.USEast1).OnAWS()
+	if err != nil {
+		t.Fatalf("Is(us-east-1) failed: %v", err)
+	}
+	if commercial.Partition != PartitionCommercial || commercial.IsIsolated() {
+		t.Errorf("us-east-1 should be commercial, got %v", commercial.Partition)
+	}
+
+	for name, set := range map[string]Set{
+		"ActiveRegions": ActiveRegions(),
+		"On.Azure":      On.Azure(),
+		"On.AWS":        On.AWS(),
+		"In.China":      In.Country("China"),
+	} {
+		for _, r := range set {
+			if r.IsIsolated() {
+				t.Errorf("%s() should not include isolated region %v", name, r.ID())
+			}
+		}
+	}
+
+	gov := InPartition(PartitionAzureGovernment)
+	if len(gov) == 0 {
+		t.Fatal("InPartition(PartitionAzureGovernment) returned no regions")
+	}
+	for _, r := range gov {
+		if r.Provider != ProviderAzure {
+			t.Errorf("Expected only Azure regions, got %v", r.ID())
+		}
+	}
+
+	china := NewQuery().InPartition(PartitionAWSChina, PartitionAzureChina).Exec()
+	providers := map[string]bool{}
+	for _, r := range china {
+		providers[r.Provider] = true
+	}
+	if !providers[ProviderAWS] || !providers[ProviderAzure] {
+		t.Errorf("Expected AWS and Azure China regions, got providers %v", providers)
+	}
+
+	if NewQuery().CommercialOnly().OnAzure().Count() != len(On.Azure()) {
+		t.Error("CommercialOnly() query should match On.Azure()")
+	}
+}
+
 func TestHas(t *testing.T) {
 	tests := []struct {
 		name string
@@ -297,6 +398,37 @@ func TestClosest(t *testing.T) {
 	}
 }
 
+func TestProviderQualifiedDistance(t *testing.T) {
+	// Singapore on AWS and Bangkok on Huawei Cloud; ap-southeast-1 is Singapore
+	// on AWS and Alibaba Cloud but Hong Kong on Huawei Cloud.
+	d, err := DistanceBetween("aws/ap-southeast-1", "huawei/ap-southeast-2")
+	if err != nil {
+		t.Fatalf("DistanceBetween() error = %v", err)
+	}
+	aws, _ := ByID("aws/ap-southeast-1")
+	huawei, _ := ByID("huawei/ap-southeast-2")
+	if d != aws.Distance(huawei) {
+		t.Errorf("DistanceBetween() = %v, want %v", d, aws.Distance(huawei))
+	}
+	if _, err := DistanceBetween("aws/ap-southeast-1", "huawei/nowhere"); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("DistanceBetween(unknown) error = %v, want ErrRegionNotFound", err)
+	}
+
+	for _, id := range []RegionID{"aws/ap-southeast-1", "alibaba/ap-southeast-1", "huawei/ap-southeast-1"} {
+		closest, err := ClosestTo(id)
+		if err != nil {
+			t.Fatalf("ClosestTo(%s) error = %v", id, err)
+		}
+		if closest.ID() == id {
+			t.Errorf("ClosestTo(%s) returned the region itself", id)
+		}
+		target, _ := ByID(id)
+		if d := target.Distance(closest); d > 50 {
+			t.Errorf("ClosestTo(%s) = %s, %.0f km away; other providers' regions sharing the code are skipped", id, closest.ID(), d)
+		}
+	}
+}
+
 func TestFilteredQueries(t *testing.T) {
 	t.Run("InCountry", func(t *testing.T) {
 		regions := InCountry("United States")
@@ -342,6 +474,13 @@ func BenchmarkIs(b *testing.B) {
 	}
 }
 
+func BenchmarkIsMiss(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Is("nope").First()
+		_, _ = Is("eastus").OnAWS()
+	}
+}
+
 func BenchmarkAre(b *testing.B) {
 	codes := []Code{"us-east-1", "us-west-2", "eu-west-1"}
 	b.ResetTimer()
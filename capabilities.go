@@ -0,0 +1,66 @@
+package where
+
+// Capabilities describes what an account is able to use, so that tooling only
+// proposes regions the account can deploy to. The zero value allows active
+// commercial regions only.
+type Capabilities struct {
+	// OptedIn lists the opt-in regions enabled for the account.
+	OptedIn []RegionID
+	// AllOptIn allows every opt-in region, e.g. for accounts that enable them all.
+	AllOptIn bool
+	// Restricted lists the restricted regions the account has been granted.
+	Restricted []RegionID
+	// Partitions lists the isolated partitions the account has credentials for.
+	Partitions []Partition
+	// Preview allows regions in preview.
+	Preview bool
+	// Deprecated allows regions that have a retirement notice.
+	Deprecated bool
+}
+
+// Allows reports whether an account with these capabilities can use the region.
+// Announced and retired regions are never usable.
+func (c Capabilities) Allows(r Region) bool {
+	if r.IsIsolated() && !containsPartition(c.Partitions, r.Partition) {
+		return false
+	}
+	switch r.Status {
+	case Active:
+		return true
+	case OptIn:
+		return c.AllOptIn || containsID(c.OptedIn, r.ID())
+	case Restricted:
+		return containsID(c.Restricted, r.ID())
+	case Preview:
+		return c.Preview
+	case Deprecated:
+		return c.Deprecated
+	default:
+		return false
+	}
+}
+
+// UsableBy filters regions that an account with the given capabilities can use.
+func (s Set) UsableBy(c Capabilities) Set {
+	return s.Filter(c.Allows)
+}
+
+// containsID reports whether ids contains id.
+func containsID(ids []RegionID, id RegionID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// containsPartition reports whether partitions contains p.
+func containsPartition(partitions []Partition, p Partition) bool {
+	for _, v := range partitions {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
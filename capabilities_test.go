@@ -0,0 +1,80 @@
+package where
+
+import "testing"
+
+func TestCapabilitiesAllows(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       RegionID
+		caps     Capabilities
+		expected bool
+	}{
+		{"active", "aws/us-east-1", Capabilities{}, true},
+		{"opt-in not enabled", "aws/af-south-1", Capabilities{}, false},
+		{"opt-in enabled", "aws/af-south-1", Capabilities{OptedIn: []RegionID{"aws/af-south-1"}}, true},
+		{"all opt-in", "aws/me-south-1", Capabilities{AllOptIn: true}, true},
+		{"restricted not granted", "azure/norwaywest", Capabilities{AllOptIn: true}, false},
+		{"restricted granted", "azure/norwaywest", Capabilities{Restricted: []RegionID{"azure/norwaywest"}}, true},
+		{"partner region", "alibaba/me-central-1", Capabilities{}, false},
+		{"isolated without access", "aws/us-gov-west-1", Capabilities{}, false},
+		{"isolated with access", "aws/us-gov-west-1", Capabilities{Partitions: []Partition{PartitionAWSGovCloud}}, true},
+		{"retired", "azure/chinaeast", Capabilities{Partitions: []Partition{PartitionAzureChina}, Deprecated: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, err := ByID(tt.id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.caps.Allows(region); got != tt.expected {
+				t.Errorf("Allows(%v) = %v, want %v", tt.id, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUsableBy(t *testing.T) {
+	all := NewQuery().OnAWS().Count()
+	usable := NewQuery().OnAWS().UsableBy(Capabilities{}).Exec()
+	if len(usable) == 0 || len(usable) >= all {
+		t.Fatalf("UsableBy() kept %d of %d AWS regions", len(usable), all)
+	}
+	for _, r := range usable {
+		if r.Status != Active || r.IsIsolated() {
+			t.Errorf("UsableBy(zero) returned %v with status %v", r.ID(), r.Status)
+		}
+	}
+
+	withAfrica := NewQuery().OnAWS().UsableBy(Capabilities{OptedIn: []RegionID{"aws/af-south-1"}}).Count()
+	if withAfrica != len(usable)+1 {
+		t.Errorf("opting in to af-south-1 should add one region, got %d -> %d", len(usable), withAfrica)
+	}
+}
+
+func TestExtendedStatus(t *testing.T) {
+	tests := []struct {
+		status Status
+		name   string
+		active bool
+	}{
+		{OptIn, "opt-in", true},
+		{Restricted, "restricted", true},
+		{Announced, "announced", false},
+		{Retired, "retired", false},
+	}
+	for _, tt := range tests {
+		if got := tt.status.String(); got != tt.name {
+			t.Errorf("String() = %v, want %v", got, tt.name)
+		}
+		if got := (Region{Status: tt.status}).IsActive(); got != tt.active {
+			t.Errorf("IsActive() for %v = %v, want %v", tt.status, got, tt.active)
+		}
+	}
+
+	for _, r := range ActiveRegions() {
+		if r.Status == Retired || r.Status == Announced {
+			t.Errorf("ActiveRegions() includes %v with status %v", r.ID(), r.Status)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package where
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+// CarbonProfile describes the emissions of running in a region. Intensity is
+// the average carbon intensity of the local grid in gCO2eq/kWh. CFE is the
+// share of hourly carbon-free energy reported by the provider, in percent, and
+// PUE the power usage effectiveness of the provider's data centers there; both
+// are zero when unknown.
+type CarbonProfile struct {
+	Intensity float64 `json:"intensity"`
+	CFE       float64 `json:"cfe,omitempty"`
+	PUE       float64 `json:"pue,omitempty"`
+}
+
+// CarbonTable maps regions to their carbon profiles. A table is immutable
+// once built and safe for concurrent use.
+type CarbonTable struct {
+	profiles map[RegionID]CarbonProfile
+}
+
+// NewCarbonTable builds a table from a map of region IDs to carbon profiles.
+func NewCarbonTable(profiles map[RegionID]CarbonProfile) *CarbonTable {
+	t := &CarbonTable{profiles: make(map[RegionID]CarbonProfile, len(profiles))}
+	for id, p := range profiles {
+		t.profiles[id] = p
+	}
+	return t
+}
+
+// ReadCarbonTable reads a table from JSON mapping region IDs to profiles, e.g.
+// {"gcp/europe-north1": {"intensity": 112, "cfe": 97, "pue": 1.09}}.
+func ReadCarbonTable(r io.Reader) (*CarbonTable, error) {
+	t := &CarbonTable{}
+	if err := json.NewDecoder(r).Decode(t); err != nil {
+		return nil, fmt.Errorf("read carbon table: %w", err)
+	}
+	return t, nil
+}
+
+// LoadCarbonTable reads a table from a JSON file; see ReadCarbonTable.
+func LoadCarbonTable(path string) (*CarbonTable, error) {
+	return loadFile(path, ReadCarbonTable)
+}
+
+// Carbon returns the carbon profile of a region, if the table has one.
+func (t *CarbonTable) Carbon(id RegionID) (CarbonProfile, bool) {
+	p, ok := t.profiles[id]
+	return p, ok
+}
+
+// MarshalJSON encodes the table in the format read by ReadCarbonTable.
+func (t *CarbonTable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.profiles)
+}
+
+// UnmarshalJSON decodes a table in the format read by ReadCarbonTable.
+func (t *CarbonTable) UnmarshalJSON(data []byte) error {
+	profiles, err := readRegionMap(bytes.NewReader(data), func(id RegionID, p CarbonProfile) error {
+		if p.Intensity < 0 || p.CFE < 0 || p.CFE > 100 || (p.PUE != 0 && p.PUE < 1) {
+			return fmt.Errorf("out of range values for %s", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	t.profiles = profiles
+	return nil
+}
+
+// carbonData is the table consulted by Carbon, MaxCarbonIntensity and SortByCarbon.
+var carbonData = dataset[CarbonTable]{baseline: baselineCarbon}
+
+// UseCarbonTable replaces the table consulted by Region.Carbon and the carbon
+// filters and sorts. Passing nil restores the baseline shipped with the package.
+func UseCarbonTable(t *CarbonTable) {
+	carbonData.use(t)
+}
+
+// CurrentCarbonTable returns the table in use, the baseline unless
+// UseCarbonTable installed another one.
+func CurrentCarbonTable() *CarbonTable {
+	return carbonData.current()
+}
+
+// Carbon returns the carbon profile of the region from the current carbon table.
+func (r Region) Carbon() (CarbonProfile, bool) {
+	return CurrentCarbonTable().Carbon(r.ID())
+}
+
+// MaxCarbonIntensity filters regions whose grid intensity is at most
+// gramsPerKWh. Regions without carbon data are dropped.
+func (s Set) MaxCarbonIntensity(gramsPerKWh float64) Set {
+	table := CurrentCarbonTable()
+	return s.Filter(func(r Region) bool {
+		p, ok := table.Carbon(r.ID())
+		return ok && p.Intensity <= gramsPerKWh
+	})
+}
+
+// SortByCarbon sorts regions by grid intensity (lowest first). Regions
+// without carbon data sort last.
+func (s Set) SortByCarbon() {
+	table := CurrentCarbonTable()
+	s.sortByScore(func(r Region) float64 {
+		if p, ok := table.Carbon(r.ID()); ok {
+			return p.Intensity
+		}
+		return math.Inf(1)
+	})
+}
+
+// GreenestNear answers "where is the cleanest grid near {location}?" - returns
+// the region with the lowest carbon intensity within the radius of a location.
+func GreenestNear(lat, lng float64, radiusKm float64) (Region, error) {
+	nearby := commercialRegions().Near(lat, lng, radiusKm).Filter(func(r Region) bool {
+		_, ok := r.Carbon()
+		return ok
+	})
+	if len(nearby) == 0 {
+		return Region{}, fmt.Errorf("%w: no region with carbon data within %.0f km", ErrRegionNotFound, radiusKm)
+	}
+	nearby.SortByCarbon()
+	return nearby[0], nil
+}
+
+// baselineCarbon holds approximate yearly figures for Google Cloud regions,
+// which publishes grid intensity and carbon-free energy per region. Values
+// shift every year; load current figures for reporting.
+var baselineCarbon = NewCarbonTable(map[RegionID]CarbonProfile{
+	"gcp/us-central1":             {Intensity: 413, CFE: 93, PUE: 1.10},
+	"gcp/us-east1":                {Intensity: 454, CFE: 27, PUE: 1.10},
+	"gcp/us-east4":                {Intensity: 323, CFE: 60, PUE: 1.10},
+	"gcp/us-east5":                {Intensity: 440, CFE: 35, PUE: 1.10},
+	"gcp/us-south1":               {Intensity: 296, CFE: 40, PUE: 1.10},
+	"gcp/us-west1":                {Intensity: 79, CFE: 91, PUE: 1.10},
+	"gcp/us-west2":                {Intensity: 169, CFE: 55, PUE: 1.10},
+	"gcp/us-west3":                {Intensity: 561, CFE: 28, PUE: 1.10},
+	"gcp/us-west4":                {Intensity: 357, CFE: 21, PUE: 1.10},
+	"gcp/northamerica-northeast1": {Intensity: 5, CFE: 99, PUE: 1.10},
+	"gcp/northamerica-northeast2": {Intensity: 30, CFE: 91, PUE: 1.10},
+	"gcp/southamerica-east1":      {Intensity: 103, CFE: 88, PUE: 1.10},
+	"gcp/southamerica-west1":      {Intensity: 190, CFE: 85, PUE: 1.10},
+	"gcp/europe-north1":           {Intensity: 112, CFE: 97, PUE: 1.09},
+	"gcp/europe-west1":            {Intensity: 103, CFE: 82, PUE: 1.09},
+	"gcp/europe-west2":            {Intensity: 172, CFE: 86, PUE: 1.10},
+	"gcp/europe-west3":            {Intensity: 276, CFE: 69, PUE: 1.10},
+	"gcp/europe-west4":            {Intensity: 209, CFE: 84, PUE: 1.10},
+	"gcp/europe-west6":            {Intensity: 15, CFE: 98, PUE: 1.10},
+	"gcp/europe-west8":            {Intensity: 202, CFE: 50, PUE: 1.10},
+	"gcp/europe-west9":            {Intensity: 59, CFE: 96, PUE: 1.10},
+	"gcp/europe-west12":           {Intensity: 202, CFE: 50, PUE: 1.10},
+	"gcp/europe-central2":         {Intensity: 643, CFE: 31, PUE: 1.10},
+	"gcp/europe-southwest1":       {Intensity: 121, CFE: 87, PUE: 1.10},
+	"gcp/me-west1":                {Intensity: 434, CFE: 5, PUE: 1.10},
+	"gcp/me-central1":             {Intensity: 440, CFE: 2, PUE: 1.10},
+	"gcp/africa-south1":           {Intensity: 657, CFE: 15, PUE: 1.10},
+	"gcp/asia-east1":              {Intensity: 456, CFE: 17, PUE: 1.10},
+	"gcp/asia-east2":              {Intensity: 360, CFE: 1, PUE: 1.10},
+	"gcp/asia-northeast1":         {Intensity: 453, CFE: 16, PUE: 1.10},
+	"gcp/asia-northeast2":         {Intensity: 296, CFE: 38, PUE: 1.10},
+	"gcp/asia-northeast3":         {Intensity: 374, CFE: 35, PUE: 1.10},
+	"gcp/asia-south1":             {Intensity: 679, CFE: 12, PUE: 1.10},
+	"gcp/asia-south2":             {Intensity: 532, CFE: 30, PUE: 1.10},
+	"gcp/asia-southeast1":         {Intensity: 372, CFE: 4, PUE: 1.10},
+	"gcp/asia-southeast2":         {Intensity: 561, CFE: 13, PUE: 1.10},
+	"gcp/australia-southeast1":    {Intensity: 598, CFE: 27, PUE: 1.10},
+	"gcp/australia-southeast2":    {Intensity: 521, CFE: 35, PUE: 1.10},
+})
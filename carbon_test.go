@@ -0,0 +1,92 @@
+package where
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBaselineCarbon(t *testing.T) {
+	for id, p := range baselineCarbon.profiles {
+		if _, err := ByID(id); err != nil {
+			t.Errorf("baseline carbon profile for unknown region %v", id)
+		}
+		if p.Intensity <= 0 || p.CFE < 0 || p.CFE > 100 || p.PUE < 1 {
+			t.Errorf("implausible carbon profile for %v: %+v", id, p)
+		}
+	}
+}
+
+func TestMaxCarbonIntensity(t *testing.T) {
+	regions := NewQuery().MaxCarbonIntensity(100).SortByCarbon().Exec()
+	if len(regions) == 0 {
+		t.Fatal("MaxCarbonIntensity() returned no regions")
+	}
+	prev := 0.0
+	for _, r := range regions {
+		p, _ := r.Carbon()
+		if p.Intensity > 100 {
+			t.Errorf("MaxCarbonIntensity(100) returned %v at %v", r.ID(), p.Intensity)
+		}
+		if p.Intensity < prev {
+			t.Error("SortByCarbon() should sort lowest intensity first")
+		}
+		prev = p.Intensity
+	}
+
+	mixed := Set{MustIs("us-east-1"), MustIs("europe-west3"), MustIs("europe-west6")}
+	mixed.SortByCarbon()
+	if got := mixed.Codes(); got[0] != "europe-west6" || got[2] != "us-east-1" {
+		t.Errorf("SortByCarbon() = %v, want europe-west6 first and regions without data last", got)
+	}
+}
+
+func TestGreenestNear(t *testing.T) {
+	// Frankfurt: Zurich and Paris are within reach and far cleaner.
+	region, err := GreenestNear(50.1109, 8.6821, 600)
+	if err != nil {
+		t.Fatalf("GreenestNear() failed: %v", err)
+	}
+	if region.Code != "europe-west6" {
+		t.Errorf("GreenestNear() = %v, want europe-west6", region.Code)
+	}
+
+	if _, err := GreenestNear(0, -140, 100); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("GreenestNear() in the Pacific error = %v, want ErrRegionNotFound", err)
+	}
+}
+
+func TestUseCarbonTable(t *testing.T) {
+	defer UseCarbonTable(nil)
+
+	table, err := ReadCarbonTable(strings.NewReader(`{"aws/eu-north-1": {"intensity": 8}}`))
+	if err != nil {
+		t.Fatalf("ReadCarbonTable() failed: %v", err)
+	}
+	UseCarbonTable(table)
+	if p, ok := MustIs("eu-north-1").Carbon(); !ok || p.Intensity != 8 || p.CFE != 0 {
+		t.Errorf("Carbon() = %+v, %v, want intensity 8 with unknown CFE", p, ok)
+	}
+	if _, ok := MustIs("europe-west6").Carbon(); ok {
+		t.Error("regions missing from the installed table should have no profile")
+	}
+
+	data, err := json.Marshal(CurrentCarbonTable())
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded CarbonTable
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if p, ok := decoded.Carbon("aws/eu-north-1"); !ok || p.Intensity != 8 {
+		t.Errorf("decoded Carbon(aws/eu-north-1) = %+v, %v, want intensity 8", p, ok)
+	}
+
+	for _, data := range []string{`{"eu-north-1": {"intensity": 8}}`, `{"aws/eu-north-1": {"intensity": 8, "cfe": 120}}`, `{"aws/eu-north-1": {"intensity": 8, "pue": 0.5}}`} {
+		if _, err := ReadCarbonTable(strings.NewReader(data)); err == nil {
+			t.Errorf("ReadCarbonTable(%s) should fail", data)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package where
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// citiesCSV is the embedded world-cities dataset: cities of more than 100,000
+// inhabitants and every national capital, one per row with the ISO 3166-1
+// alpha-2 country code, coordinates and approximate population.
+//
+//go:embed cities.csv
+var citiesCSV string
+
+// City is a populated place from the embedded world-cities dataset, used to
+// turn city names into coordinates without a network round trip.
+type City struct {
+	Name       string  `json:"name"`
+	Country    string  `json:"country"` // ISO 3166-1 alpha-2 code, e.g. "PL"
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Population int     `json:"population"`
+}
+
+// Distance calculates the great-circle distance to a region in kilometers.
+func (c City) Distance(r Region) float64 {
+	return haversineDistance(c.Latitude, c.Longitude, r.Latitude, r.Longitude)
+}
+
+// Closest returns the regions of the set sorted by distance from the city,
+// leaving the set itself untouched.
+func (c City) Closest(s Set) Set {
+	sorted := append(Set{}, s...)
+	sorted.SortByDistance(c.Latitude, c.Longitude)
+	return sorted
+}
+
+// worldCities holds the parsed dataset, most populous first.
+var worldCities = mustParseCities(citiesCSV)
+
+// mustParseCities parses the embedded dataset; a malformed row is a bug in
+// the package, not a runtime condition.
+func mustParseCities(data string) []City {
+	rows, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("parse cities: %v", err))
+	}
+	cities := make([]City, 0, len(rows))
+	for i, row := range rows {
+		if i == 0 {
+			continue // header
+		}
+		lat, err1 := strconv.ParseFloat(row[2], 64)
+		lng, err2 := strconv.ParseFloat(row[3], 64)
+		pop, err3 := strconv.Atoi(row[4])
+		if err1 != nil || err2 != nil || err3 != nil {
+			panic(fmt.Sprintf("parse cities: bad row %d: %v", i+1, row))
+		}
+		cities = append(cities, City{Name: row[0], Country: row[1], Latitude: lat, Longitude: lng, Population: pop})
+	}
+	sort.SliceStable(cities, func(i, j int) bool {
+		return cities[i].Population > cities[j].Population
+	})
+	return cities
+}
+
+// WorldCities returns the embedded cities, most populous first.
+func WorldCities() []City {
+	return append([]City{}, worldCities...)
+}
+
+// CitiesIn returns the embedded cities of a country, most populous first.
+// The country is an ISO 3166-1 alpha-2 code, in any case.
+func CitiesIn(country string) []City {
+	var cities []City
+	for _, c := range worldCities {
+		if strings.EqualFold(c.Country, country) {
+			cities = append(cities, c)
+		}
+	}
+	return cities
+}
+
+// FindCity looks a city up in the embedded dataset, ignoring case and accents
+// and honouring known aliases such as "Bengaluru". Names shared by several
+// cities resolve to the most populous one; qualify the name with a country
+// code to pick another, e.g. "London, CA" or "Valencia, VE".
+func FindCity(name string) (City, error) {
+	query, country := name, ""
+	if i := strings.LastIndex(name, ","); i >= 0 {
+		query, country = name[:i], strings.TrimSpace(name[i+1:])
+	}
+	for _, c := range worldCities {
+		if sameName(query, c.Name) && (country == "" || strings.EqualFold(country, c.Country)) {
+			return c, nil
+		}
+	}
+	return City{}, fmt.Errorf("%w: %q", ErrCityNotFound, name)
+}
+
+// locateCity resolves a city name to coordinates: a region city of the
+// catalog first, so existing locations keep their reference points, then the
+// embedded world-cities dataset.
+func locateCity(name string) (lat, lng float64, err error) {
+	if regions := allRegions().ByCity(name); len(regions) > 0 {
+		return regions[0].Latitude, regions[0].Longitude, nil
+	}
+	city, err := FindCity(name)
+	if err != nil {
+		return 0, 0, err
+	}
+	return city.Latitude, city.Longitude, nil
+}
@@ -0,0 +1,95 @@
+package where
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestWorldCities(t *testing.T) {
+	cities := WorldCities()
+	if len(cities) < 500 {
+		t.Fatalf("WorldCities() = %d cities, want at least 500", len(cities))
+	}
+	for i, c := range cities {
+		if len(c.Country) != 2 || c.Name == "" || math.Abs(c.Latitude) > 90 || math.Abs(c.Longitude) > 180 {
+			t.Errorf("WorldCities()[%d] = %+v is malformed", i, c)
+		}
+		if i > 0 && c.Population > cities[i-1].Population {
+			t.Errorf("WorldCities() not sorted by population at %s", c.Name)
+		}
+	}
+	if got := CitiesIn("pl"); len(got) == 0 || got[0].Name != "Warsaw" {
+		t.Errorf("CitiesIn(pl) = %v, want Warsaw first", got)
+	}
+}
+
+func TestFindCity(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		country string
+	}{
+		{"Warsaw", "Warsaw", "PL"},
+		{"warszawa", "Warsaw", "PL"},
+		{"LAGOS", "Lagos", "NG"},
+		{"São Paulo", "Sao Paulo", "BR"},
+		{"Bengaluru", "Bangalore", "IN"},
+		{"London", "London", "GB"},
+		{"London, CA", "London", "CA"},
+		{"Valencia, ve", "Valencia", "VE"},
+	}
+	for _, tt := range tests {
+		got, err := FindCity(tt.name)
+		if err != nil {
+			t.Errorf("FindCity(%s) error = %v", tt.name, err)
+			continue
+		}
+		if got.Name != tt.want || got.Country != tt.country {
+			t.Errorf("FindCity(%s) = %s, %s, want %s, %s", tt.name, got.Name, got.Country, tt.want, tt.country)
+		}
+	}
+
+	if _, err := FindCity("Atlantis"); !errors.Is(err, ErrCityNotFound) {
+		t.Errorf("FindCity(Atlantis) error = %v, want ErrCityNotFound", err)
+	}
+	if _, err := FindCity("Warsaw, DE"); !errors.Is(err, ErrCityNotFound) {
+		t.Errorf("FindCity(Warsaw, DE) error = %v, want ErrCityNotFound", err)
+	}
+}
+
+func TestNearCityGeocoded(t *testing.T) {
+	regions, errs := NewQuery().OnProvider("aws").NearCity("Lagos", 2000).ExecWithErrors()
+	if len(errs) != 0 {
+		t.Fatalf("NearCity(Lagos) errors = %v", errs)
+	}
+	if len(regions) != 0 {
+		t.Errorf("NearCity(Lagos, 2000) on AWS = %v, want none", regions.Codes())
+	}
+
+	warsaw := NewQuery().OnProvider("aws").NearCity("Warsaw", 800).Codes()
+	if len(warsaw) == 0 {
+		t.Error("NearCity(Warsaw, 800) on AWS returned no regions")
+	}
+	for _, code := range warsaw {
+		if code == "eu-west-1" {
+			t.Errorf("NearCity(Warsaw, 800) includes eu-west-1 (Dublin)")
+		}
+	}
+
+	if got := Proximity.City("Lagos", 5000); len(got) == 0 {
+		t.Error("Proximity.City(Lagos, 5000) returned no regions")
+	}
+	if got := Proximity.City("Atlantis", 5000); len(got) != 0 {
+		t.Errorf("Proximity.City(Atlantis) = %v, want none", got.Codes())
+	}
+	if _, errs := NewQuery().NearCity("Atlantis", 100).ExecWithErrors(); len(errs) != 1 || !errors.Is(errs[0], ErrCityNotFound) {
+		t.Errorf("NearCity(Atlantis) errors = %v, want ErrCityNotFound", errs)
+	}
+
+	joburg, _ := FindCity("Johannesburg")
+	closest := joburg.Closest(OnProvider("aws"))
+	if len(closest) == 0 || closest[0].Code != "af-south-1" {
+		t.Errorf("Closest(Johannesburg) = %v, want af-south-1 first", closest.Codes())
+	}
+}
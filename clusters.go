@@ -0,0 +1,139 @@
+package where
+
+import (
+	"math"
+	"sort"
+)
+
+// MetroRadiusKm is the clustering radius used by Metros: regions whose
+// cluster centroids are this close are treated as one metro area.
+const MetroRadiusKm = 100
+
+// Cluster is a group of co-located regions, possibly across providers.
+type Cluster struct {
+	Name      string  // the most common city among the regions
+	Latitude  float64 // centroid of the regions
+	Longitude float64
+	Regions   Set
+}
+
+// Providers returns the sorted provider names present in the cluster.
+func (c Cluster) Providers() []string {
+	return c.Regions.providers()
+}
+
+// Radius returns the distance in kilometers from the centroid to the farthest
+// region of the cluster.
+func (c Cluster) Radius() float64 {
+	var radius float64
+	for _, r := range c.Regions {
+		radius = math.Max(radius, haversineDistance(c.Latitude, c.Longitude, r.Latitude, r.Longitude))
+	}
+	return radius
+}
+
+// ClusterBy groups co-located regions by agglomerative clustering: the two
+// clusters with the closest centroids are merged until no two centroids are
+// within radiusKm. Clusters are returned largest first, then by name.
+func (s Set) ClusterBy(radiusKm float64) []Cluster {
+	clusters := make([]Cluster, len(s))
+	for i, r := range s {
+		clusters[i] = Cluster{Latitude: r.Latitude, Longitude: r.Longitude, Regions: Set{r}}
+	}
+	// dist caches centroid distances; merged-away clusters are marked dead.
+	dist := make([][]float64, len(clusters))
+	for i := range clusters {
+		dist[i] = make([]float64, len(clusters))
+		for j := range clusters {
+			dist[i][j] = clusters[i].distance(clusters[j])
+		}
+	}
+	dead := make([]bool, len(clusters))
+	for {
+		bi, bj, best := -1, -1, math.Inf(1)
+		for i := range clusters {
+			for j := i + 1; j < len(clusters); j++ {
+				if !dead[i] && !dead[j] && dist[i][j] <= radiusKm && dist[i][j] < best {
+					bi, bj, best = i, j, dist[i][j]
+				}
+			}
+		}
+		if bi < 0 {
+			break
+		}
+		merged := append(append(Set{}, clusters[bi].Regions...), clusters[bj].Regions...)
+		lat, lng := centroid(merged)
+		clusters[bi] = Cluster{Latitude: lat, Longitude: lng, Regions: merged}
+		dead[bj] = true
+		for j := range clusters {
+			dist[bi][j] = clusters[bi].distance(clusters[j])
+			dist[j][bi] = dist[bi][j]
+		}
+	}
+
+	alive := clusters[:0]
+	for i, c := range clusters {
+		if !dead[i] {
+			alive = append(alive, c)
+		}
+	}
+	clusters = alive
+	for i := range clusters {
+		clusters[i].Name = mostCommonCity(clusters[i].Regions)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		if len(clusters[i].Regions) != len(clusters[j].Regions) {
+			return len(clusters[i].Regions) > len(clusters[j].Regions)
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters
+}
+
+// distance returns the distance in kilometers between cluster centroids.
+func (c Cluster) distance(other Cluster) float64 {
+	return haversineDistance(c.Latitude, c.Longitude, other.Latitude, other.Longitude)
+}
+
+// Metros groups the regions of the set into metro areas; see ClusterBy and
+// MetroRadiusKm.
+func (s Set) Metros() []Cluster {
+	return s.ClusterBy(MetroRadiusKm)
+}
+
+// Metros answers "where are the metros?" - groups the commercial regions of
+// all providers into metro areas, e.g. Frankfurt with AWS, Azure, Google
+// Cloud and Alibaba Cloud regions.
+func Metros() []Cluster {
+	return commercialRegions().Metros()
+}
+
+// centroid returns the geographic center of the regions, averaged on the
+// sphere so that clusters across the antimeridian stay in place.
+func centroid(s Set) (float64, float64) {
+	const rad = math.Pi / 180
+	var x, y, z float64
+	for _, r := range s {
+		lat, lng := r.Latitude*rad, r.Longitude*rad
+		x += math.Cos(lat) * math.Cos(lng)
+		y += math.Cos(lat) * math.Sin(lng)
+		z += math.Sin(lat)
+	}
+	return math.Atan2(z, math.Hypot(x, y)) / rad, math.Atan2(y, x) / rad
+}
+
+// mostCommonCity returns the city shared by most regions of the set, the
+// alphabetically first on ties.
+func mostCommonCity(s Set) string {
+	counts := make(map[string]int)
+	for _, r := range s {
+		counts[r.City]++
+	}
+	var city string
+	for c, n := range counts {
+		if n > counts[city] || (n == counts[city] && c < city) {
+			city = c
+		}
+	}
+	return city
+}
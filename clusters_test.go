@@ -0,0 +1,75 @@
+package where
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestClusterBy(t *testing.T) {
+	awsFra, _ := Is("eu-central-1").OnAWS()
+	aliFra, _ := Is("eu-central-1").OnAlibaba()
+	azureFra, _ := Is("germanywestcentral").OnAzure()
+	awsDub, _ := Is("eu-west-1").OnAWS()
+	set := Set{awsFra, awsDub, aliFra, azureFra}
+
+	clusters := set.ClusterBy(50)
+	if len(clusters) != 2 {
+		t.Fatalf("ClusterBy(50) = %d clusters, want 2", len(clusters))
+	}
+	frankfurt := clusters[0]
+	if frankfurt.Name != "Frankfurt" || len(frankfurt.Regions) != 3 {
+		t.Errorf("ClusterBy(50)[0] = %s with %d regions, want Frankfurt with 3", frankfurt.Name, len(frankfurt.Regions))
+	}
+	if got, want := frankfurt.Providers(), []string{"alibaba", "aws", "azure"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Providers() = %v, want %v", got, want)
+	}
+	if frankfurt.Radius() > 50 {
+		t.Errorf("Radius() = %.1f, want at most 50", frankfurt.Radius())
+	}
+	if clusters[1].Regions[0].ID() != "aws/eu-west-1" {
+		t.Errorf("ClusterBy(50)[1] = %v, want aws/eu-west-1 alone", clusters[1].Regions.Codes())
+	}
+
+	if got := set.ClusterBy(2000); len(got) != 1 {
+		t.Errorf("ClusterBy(2000) = %d clusters, want 1", len(got))
+	}
+	if got := (Set{}).ClusterBy(100); len(got) != 0 {
+		t.Errorf("ClusterBy() on empty set = %v", got)
+	}
+}
+
+func TestCentroidAntimeridian(t *testing.T) {
+	set := Set{{Latitude: 0, Longitude: 179}, {Latitude: 0, Longitude: -179}}
+	lat, lng := centroid(set)
+	if math.Abs(lat) > 1e-9 || math.Abs(math.Abs(lng)-180) > 1e-9 {
+		t.Errorf("centroid() = %.3f, %.3f, want 0, ±180", lat, lng)
+	}
+}
+
+func TestMetros(t *testing.T) {
+	metros := Metros()
+	if len(metros) == 0 || len(metros) >= len(commercialRegions()) {
+		t.Fatalf("Metros() = %d clusters for %d regions", len(metros), len(commercialRegions()))
+	}
+	total := 0
+	for i, m := range metros {
+		total += len(m.Regions)
+		if i > 0 && len(m.Regions) > len(metros[i-1].Regions) {
+			t.Errorf("Metros() not sorted by size at %d", i)
+		}
+	}
+	if total != len(commercialRegions()) {
+		t.Errorf("Metros() cover %d regions, want %d", total, len(commercialRegions()))
+	}
+
+	for _, m := range metros {
+		if m.Name == "Frankfurt" {
+			if len(m.Providers()) < 3 {
+				t.Errorf("Frankfurt metro providers = %v, want at least 3", m.Providers())
+			}
+			return
+		}
+	}
+	t.Error("Metros() has no Frankfurt metro")
+}
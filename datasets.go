@@ -0,0 +1,70 @@
+package where
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// readRegionMap decodes a JSON object keyed by region ID, the format shared
+// by the loadable service, price and carbon datasets. Keys need not name
+// catalog regions, so datasets may cover regions the catalog does not know
+// yet, but must have the "provider/code" form; they are lowercased to match
+// Region.ID. check, when not nil, validates each value.
+func readRegionMap[T any](r io.Reader, check func(RegionID, T) error) (map[RegionID]T, error) {
+	var raw map[RegionID]T
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+	values := make(map[RegionID]T, len(raw))
+	for key, v := range raw {
+		if key.Provider() == "" || key.Code() == "" {
+			return nil, fmt.Errorf("invalid region ID %q, want \"provider/code\"", key)
+		}
+		id := NewRegionID(key.Provider(), Code(strings.ToLower(string(key.Code()))))
+		if _, ok := values[id]; ok {
+			return nil, fmt.Errorf("duplicate region ID %q", id)
+		}
+		if check != nil {
+			if err := check(id, v); err != nil {
+				return nil, err
+			}
+		}
+		values[id] = v
+	}
+	return values, nil
+}
+
+// loadFile opens a dataset file and reads it with read.
+func loadFile[T any](path string, read func(io.Reader) (T, error)) (T, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	defer f.Close()
+	return read(f)
+}
+
+// dataset holds an installable dataset: the value installed with use, or the
+// baseline shipped with the package until one is.
+type dataset[T any] struct {
+	installed atomic.Pointer[T]
+	baseline  *T
+}
+
+// use installs v; nil restores the baseline.
+func (d *dataset[T]) use(v *T) {
+	d.installed.Store(v)
+}
+
+// current returns the installed value, or the baseline if none is.
+func (d *dataset[T]) current() *T {
+	if v := d.installed.Load(); v != nil {
+		return v
+	}
+	return d.baseline
+}
@@ -0,0 +1,40 @@
+package where
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReadRegionMap(t *testing.T) {
+	got, err := readRegionMap[int](strings.NewReader(`{"aws/us-east-99": 1, "test/x": 2}`), nil)
+	if err != nil {
+		t.Fatalf("readRegionMap() error = %v", err)
+	}
+	if got["aws/us-east-99"] != 1 || got["test/x"] != 2 {
+		t.Errorf("readRegionMap() = %v, want regions outside the catalog kept", got)
+	}
+
+	got, err = readRegionMap[int](strings.NewReader(`{"AWS/US-East-1": 1}`), nil)
+	if err != nil || got["aws/us-east-1"] != 1 {
+		t.Errorf("readRegionMap() = %v, %v, want keys lowercased", got, err)
+	}
+	if _, err := readRegionMap[int](strings.NewReader(`{"aws/us-east-1": 1, "AWS/us-east-1": 2}`), nil); err == nil {
+		t.Error("readRegionMap() should reject keys that differ in case only")
+	}
+
+	if _, err := readRegionMap[int](strings.NewReader(`{"us-east-1": 1}`), nil); err == nil {
+		t.Error("readRegionMap() should reject IDs without a provider")
+	}
+
+	errNegative := errors.New("negative")
+	check := func(id RegionID, v int) error {
+		if v < 0 {
+			return errNegative
+		}
+		return nil
+	}
+	if _, err := readRegionMap(strings.NewReader(`{"aws/us-east-1": -1}`), check); !errors.Is(err, errNegative) {
+		t.Errorf("readRegionMap() error = %v, want the check error", err)
+	}
+}
@@ -0,0 +1,235 @@
+package where
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMetadataURL is the base URL of the instance metadata services of
+// AWS, Google Cloud and Azure, which all answer on the link-local address.
+const DefaultMetadataURL = "http://169.254.169.254"
+
+// defaultMetadataTimeout bounds each metadata request when Detector.Timeout is zero.
+const defaultMetadataTimeout = time.Second
+
+// envRegionVars lists the environment variables that name the current region,
+// in the order they are consulted.
+var envRegionVars = []struct {
+	name     string
+	provider string
+}{
+	{"AWS_REGION", ProviderAWS},
+	{"AWS_DEFAULT_REGION", ProviderAWS},
+	{"GOOGLE_CLOUD_REGION", ProviderGCP},
+	{"CLOUDSDK_COMPUTE_REGION", ProviderGCP},
+	{"FUNCTION_REGION", ProviderGCP},
+	{"REGION_NAME", ProviderAzure}, // App Service, as a display name like "West Europe"
+	{"AZURE_REGION", ProviderAzure},
+	{"FLY_REGION", ProviderFly},
+	{"ALIBABA_CLOUD_REGION_ID", ProviderAlibaba},
+	{"TENCENTCLOUD_REGION", ProviderTencent},
+}
+
+// Detector finds the region the current process runs in. The zero value
+// reads environment variables only.
+type Detector struct {
+	// Metadata also queries the AWS (IMDSv2), Google Cloud and Azure instance
+	// metadata services when the environment names no region.
+	Metadata bool
+	// MetadataURL is the base URL of the metadata services, DefaultMetadataURL
+	// when empty. Point it at a stand-in server in tests.
+	MetadataURL string
+	// Timeout bounds each metadata request, one second when zero.
+	Timeout time.Duration
+	// Client sends metadata requests, http.DefaultClient when nil.
+	Client *http.Client
+	// Getenv reads environment variables, os.Getenv when nil.
+	Getenv func(string) string
+}
+
+// Current answers "where am I?" - detects the region the process runs in from
+// environment variables such as AWS_REGION, GOOGLE_CLOUD_REGION or FLY_REGION.
+// Use a Detector to fall back to instance metadata services.
+func Current(ctx context.Context) (Region, error) {
+	return Detector{}.Detect(ctx)
+}
+
+// Detect returns the catalog region the process runs in, consulting the
+// environment first and then, if enabled, the instance metadata services.
+// Values that name no catalog region are skipped in favor of the next
+// source; the error reports them all when no source resolves.
+func (d Detector) Detect(ctx context.Context) (Region, error) {
+	getenv := d.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+	var errs []error
+	for _, v := range envRegionVars {
+		value := getenv(v.name)
+		if value == "" {
+			continue
+		}
+		region, err := Is(normalizeDetected(v.provider, value)).OnProvider(v.provider)
+		if err == nil {
+			return region, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", v.name, err))
+	}
+	if d.Metadata {
+		region, err := d.detectMetadata(ctx)
+		if err == nil {
+			return region, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return Region{}, fmt.Errorf("%w: no region in the environment", ErrRegionNotFound)
+	}
+	return Region{}, errors.Join(errs...)
+}
+
+// normalizeDetected turns a detected region name into a catalog code. Azure
+// reports display names like "West Europe" in some environments.
+func normalizeDetected(provider, value string) Code {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if provider == ProviderAzure {
+		value = strings.ReplaceAll(value, " ", "")
+	}
+	return Code(value)
+}
+
+// metadataProbes lists the metadata services in order of preference, each
+// returning the region code it reports.
+var metadataProbes = []struct {
+	provider string
+	probe    func(d Detector, ctx context.Context) (string, error)
+}{
+	{ProviderAWS, Detector.probeAWS},
+	{ProviderGCP, Detector.probeGCP},
+	{ProviderAzure, Detector.probeAzure},
+}
+
+// detectMetadata queries all metadata services concurrently and returns the
+// region reported by the first one, in metadataProbes order, that answers
+// with a catalog region.
+func (d Detector) detectMetadata(ctx context.Context) (Region, error) {
+	codes := make([]string, len(metadataProbes))
+	errs := make([]error, len(metadataProbes))
+	var wg sync.WaitGroup
+	for i, m := range metadataProbes {
+		wg.Add(1)
+		go func(i int, probe func(Detector, context.Context) (string, error)) {
+			defer wg.Done()
+			codes[i], errs[i] = probe(d, ctx)
+		}(i, m.probe)
+	}
+	wg.Wait()
+
+	for i, m := range metadataProbes {
+		if errs[i] != nil {
+			continue
+		}
+		region, err := Is(normalizeDetected(m.provider, codes[i])).OnProvider(m.provider)
+		if err == nil {
+			return region, nil
+		}
+		errs[i] = fmt.Errorf("%s: %w", m.provider, err)
+	}
+	return Region{}, fmt.Errorf("%w: no instance metadata service answered: %w", ErrRegionNotFound, errors.Join(errs...))
+}
+
+// probeAWS reads the region from IMDSv2, which requires a session token.
+func (d Detector) probeAWS(ctx context.Context) (string, error) {
+	token, err := d.fetch(ctx, http.MethodPut, "/latest/api/token", map[string]string{
+		"X-aws-ec2-metadata-token-ttl-seconds": "60",
+	})
+	if err != nil {
+		return "", fmt.Errorf("aws: %w", err)
+	}
+	region, err := d.fetch(ctx, http.MethodGet, "/latest/meta-data/placement/region", map[string]string{
+		"X-aws-ec2-metadata-token": token,
+	})
+	if err != nil {
+		return "", fmt.Errorf("aws: %w", err)
+	}
+	return region, nil
+}
+
+// probeGCP reads the zone from the GCE metadata server, which reports it as
+// "projects/{number}/zones/{zone}", and strips the zone suffix.
+func (d Detector) probeGCP(ctx context.Context) (string, error) {
+	zone, err := d.fetch(ctx, http.MethodGet, "/computeMetadata/v1/instance/zone", map[string]string{
+		"Metadata-Flavor": "Google",
+	})
+	if err != nil {
+		return "", fmt.Errorf("gcp: %w", err)
+	}
+	zone = zone[strings.LastIndex(zone, "/")+1:]
+	i := strings.LastIndex(zone, "-")
+	if i <= 0 {
+		return "", fmt.Errorf("gcp: unexpected zone %q", zone)
+	}
+	return zone[:i], nil
+}
+
+// probeAzure reads the location from the Azure instance metadata service.
+func (d Detector) probeAzure(ctx context.Context) (string, error) {
+	location, err := d.fetch(ctx, http.MethodGet, "/metadata/instance/compute/location?api-version=2021-02-01&format=text", map[string]string{
+		"Metadata": "true",
+	})
+	if err != nil {
+		return "", fmt.Errorf("azure: %w", err)
+	}
+	return location, nil
+}
+
+// fetch sends a metadata request bounded by the detector's timeout and
+// returns the trimmed response body.
+func (d Detector) fetch(ctx context.Context, method, path string, header map[string]string) (string, error) {
+	timeout := d.Timeout
+	if timeout <= 0 {
+		timeout = defaultMetadataTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	base := d.MetadataURL
+	if base == "" {
+		base = DefaultMetadataURL
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(base, "/")+path, nil)
+	if err != nil {
+		return "", err
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s %s: %s", method, path, resp.Status)
+	}
+	value := strings.TrimSpace(string(body))
+	if value == "" {
+		return "", fmt.Errorf("%s %s: empty response", method, path)
+	}
+	return value, nil
+}
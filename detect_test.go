@@ -0,0 +1,142 @@
+package where
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// env returns a Getenv function backed by a map.
+func env(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func TestDetectEnvironment(t *testing.T) {
+	tests := []struct {
+		vars map[string]string
+		want RegionID
+	}{
+		{map[string]string{"AWS_REGION": "eu-west-1", "AWS_DEFAULT_REGION": "us-east-1"}, "aws/eu-west-1"},
+		{map[string]string{"AWS_DEFAULT_REGION": "us-east-1"}, "aws/us-east-1"},
+		{map[string]string{"GOOGLE_CLOUD_REGION": "europe-west4"}, "gcp/europe-west4"},
+		{map[string]string{"REGION_NAME": "Sweden Central"}, "azure/swedencentral"},
+		{map[string]string{"FLY_REGION": "ams"}, "fly/ams"},
+		{map[string]string{"ALIBABA_CLOUD_REGION_ID": "us-east-1"}, "alibaba/us-east-1"},
+	}
+	for _, tt := range tests {
+		got, err := Detector{Getenv: env(tt.vars)}.Detect(context.Background())
+		if err != nil || got.ID() != tt.want {
+			t.Errorf("Detect(%v) = %v, %v, want %v", tt.vars, got.ID(), err, tt.want)
+		}
+	}
+
+	if _, err := (Detector{Getenv: env(nil)}).Detect(context.Background()); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("Detect(empty environment) error = %v, want ErrRegionNotFound", err)
+	}
+	if _, err := (Detector{Getenv: env(map[string]string{"AWS_REGION": "mars-1"})}).Detect(context.Background()); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("Detect(AWS_REGION=mars-1) error = %v, want ErrRegionNotFound", err)
+	}
+	// An invalid value gives way to the next variable that resolves.
+	vars := map[string]string{"AWS_REGION": "mars-1", "AWS_DEFAULT_REGION": "eu-central-1"}
+	if got, err := (Detector{Getenv: env(vars)}).Detect(context.Background()); err != nil || got.ID() != "aws/eu-central-1" {
+		t.Errorf("Detect(%v) = %v, %v, want aws/eu-central-1", vars, got.ID(), err)
+	}
+}
+
+// metadataServer stands in for the metadata service of one provider.
+func metadataServer(t *testing.T, provider string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	switch provider {
+	case ProviderAWS:
+		mux.HandleFunc("/latest/api/token", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.Header.Get("X-aws-ec2-metadata-token-ttl-seconds") == "" {
+				http.Error(w, "bad token request", http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte("token-123"))
+		})
+		mux.HandleFunc("/latest/meta-data/placement/region", func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-aws-ec2-metadata-token") != "token-123" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte("ap-southeast-2"))
+		})
+	case ProviderGCP:
+		mux.HandleFunc("/computeMetadata/v1/instance/zone", func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Metadata-Flavor") != "Google" {
+				http.Error(w, "missing header", http.StatusForbidden)
+				return
+			}
+			w.Write([]byte("projects/123456/zones/europe-west1-b"))
+		})
+	case ProviderAzure:
+		mux.HandleFunc("/metadata/instance/compute/location", func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Metadata") != "true" || r.URL.Query().Get("format") != "text" {
+				http.Error(w, "bad request", http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte("uksouth\n"))
+		})
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDetectMetadata(t *testing.T) {
+	tests := []struct {
+		provider string
+		want     RegionID
+	}{
+		{ProviderAWS, "aws/ap-southeast-2"},
+		{ProviderGCP, "gcp/europe-west1"},
+		{ProviderAzure, "azure/uksouth"},
+	}
+	for _, tt := range tests {
+		srv := metadataServer(t, tt.provider)
+		d := Detector{Metadata: true, MetadataURL: srv.URL, Getenv: env(nil)}
+		got, err := d.Detect(context.Background())
+		if err != nil || got.ID() != tt.want {
+			t.Errorf("Detect(%s metadata) = %v, %v, want %v", tt.provider, got.ID(), err, tt.want)
+		}
+		if got.Name == "" || got.Latitude == 0 {
+			t.Errorf("Detect(%s metadata) returned an unpopulated region: %+v", tt.provider, got)
+		}
+	}
+
+	// An environment value naming no region falls through to the metadata.
+	srv := metadataServer(t, ProviderAWS)
+	d := Detector{Metadata: true, MetadataURL: srv.URL, Getenv: env(map[string]string{"AWS_REGION": "mars-1"})}
+	if got, err := d.Detect(context.Background()); err != nil || got.ID() != "aws/ap-southeast-2" {
+		t.Errorf("Detect(AWS_REGION=mars-1 with metadata) = %v, %v, want aws/ap-southeast-2", got.ID(), err)
+	}
+}
+
+func TestDetectMetadataUnavailable(t *testing.T) {
+	srv := metadataServer(t, "")
+	d := Detector{Metadata: true, MetadataURL: srv.URL, Getenv: env(nil)}
+	if _, err := d.Detect(context.Background()); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("Detect(no metadata) error = %v, want ErrRegionNotFound", err)
+	}
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	t.Cleanup(slow.Close)
+	d = Detector{Metadata: true, MetadataURL: slow.URL, Timeout: 50 * time.Millisecond, Getenv: env(nil)}
+	start := time.Now()
+	if _, err := d.Detect(context.Background()); err == nil {
+		t.Error("Detect(slow metadata) succeeded, want timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Detect(slow metadata) took %v, want it bounded by the timeout", elapsed)
+	}
+}
@@ -1,6 +1,7 @@
 /*
 Package where provides a unified Go library for discovering, querying, and working
-with cloud provider regions across AWS, Azure, and Google Cloud Platform.
+with cloud provider regions across AWS, Azure, Google Cloud Platform, Alibaba Cloud,
+Yandex Cloud, Tencent Cloud, Huawei Cloud, DigitalOcean, Akamai Cloud (Linode), Vultr, and Fly.io.
 
 # Overview
 
@@ -62,7 +63,12 @@ The where package is designed around natural language patterns that read like qu
 
 **Note**: Some region codes exist across multiple providers (e.g., "us-east-1"
 in both AWS and Alibaba). The Are() function returns ALL matching regions.
-Use provider-specific queries or constants for precise control.
+Use provider-specific queries or constants for precise control, or look a
+region up by its provider-qualified RegionID:
+
+	region, err := where.ByID("huawei/ap-southeast-1") // Hong Kong, not Singapore
+	km, err := where.DistanceBetween("aws/ap-southeast-1", "huawei/ap-southeast-1")
+	next, err := where.ClosestTo("alibaba/ap-southeast-1")
 
 # Provider Constants
 
@@ -89,6 +95,13 @@ Microsoft Azure:
 	where.Azure.WestEurope  // westeurope (Netherlands)
 	where.Azure.SoutheastAsia // southeastasia (Singapore)
 
+Developer clouds:
+
+	where.DigitalOcean.NYC1 // nyc1 (New York)
+	where.Linode.EUCentral  // eu-central (Frankfurt)
+	where.Vultr.EWR         // ewr (New Jersey)
+	where.Fly.CDG           // cdg (Paris)
+
 # Geographic Queries
 
 The In namespace provides geographic-based region discovery:
@@ -115,8 +128,263 @@ The By namespace provides provider-based region discovery:
 	where.On.AWS()      // All Amazon Web Services regions
 	where.On.Azure()    // All Microsoft Azure regions
 	where.On.GCP()      // All Google Cloud Platform regions
+	where.On.Fly()      // All Fly.io regions
 	where.On.Provider("aws")    // Same as where.On.AWS()
 
+# Partitions
+
+Government, sovereign and China regions live in isolated partitions that need
+separate accounts. Catalog-wide queries such as ActiveRegions() or On.Azure()
+only return commercial regions; isolated ones are requested explicitly:
+
+	where.InPartition(where.PartitionAzureGovernment) // usgovvirginia, usgovtexas, ...
+	where.In.Partition(where.PartitionAWSChina)       // cn-north-1, cn-northwest-1
+	where.NewQuery().InPartition(where.PartitionAWSGovCloud).Exec()
+
+Lookups by code (Is, Are, ByID) and NewQuery() cover every partition.
+
+# Edge Locations
+
+CDN and edge points of presence are modelled as Location values, keyed by the
+IATA code of the metro they serve, and support the same geographic filters.
+CloudFront is listed by its regional edge caches and Cloudflare by its data
+centers in major metros:
+
+	where.Edge.Cloudflare()                  // Cloudflare data centers in major metros
+	where.Edge.InCountry("Japan")            // PoPs of every network in Japan
+	where.Edge.Near(50.11, 8.68, 300)        // PoPs within 300km of Frankfurt
+	region.NearestEdge(where.NetworkCloudFront) // CloudFront PoP closest to an origin
+
+# Zones
+
+Regions list their availability zones by name. AWS Local Zones and Wavelength
+Zones are modelled as Zone values with their own coordinates, parent region,
+zone group and opt-in requirement, so they are found by proximity too:
+
+	where.LocalZones()                        // All local zones
+	where.NearZones(42.36, -71.06, 50)        // Compute within 50km of Boston
+	region.ExtendedZones()                    // Local and wavelength zones of a region
+	zone, err := where.FindZone("us-west-2-lax-1a")
+
+# Resource Identifiers
+
+Regions can be recovered from the identifiers cloud APIs hand out. Failures
+return a *ParseError explaining what could not be parsed:
+
+	where.FromARN("arn:aws:sqs:us-west-2:123456789012:queue")
+	where.FromAzureResourceID(armJSON)          // uses the "location" field
+	where.FromGCPSelfLink(".../regions/europe-west1/subnetworks/default")
+	where.FromZone("europe-west1-b")
+	where.FromEndpoint("ec2.eu-west-1.amazonaws.com")
+
+# Endpoints
+
+Service hostnames are built from the region's provider, code and partition:
+
+	region.Endpoint("s3")                     // "s3.eu-west-1.amazonaws.com"
+	region.Endpoint("ec2", where.FIPS)        // "ec2-fips.us-east-1.amazonaws.com"
+	region.Endpoint("ec2", where.DualStack)   // "ec2.us-east-1.api.aws"
+	regions.Endpoints("s3")                   // hostnames for an allow-list
+
+# Service Availability
+
+A service matrix records which services each region offers. The package
+ships a baseline; install a current export with UseServiceMatrix:
+
+	where.NewQuery().WithService("aws", "bedrock").Exec()
+	region.Supports("azure", "cosmosdb")
+	regions.CommonServices()                  // services offered everywhere in the set
+
+	matrix, err := where.LoadServiceMatrix("services.json")
+	where.UseServiceMatrix(matrix)
+
+# Instance Offerings
+
+Instance type and VM SKU availability is loaded from provider exports
+("aws ec2 describe-instance-type-offerings", "az vm list-skus"):
+
+	offerings, err := where.LoadInstanceOfferings("aws.json", "azure.json")
+	where.UseInstanceOfferings(offerings)
+	where.NewQuery().OffersInstance("p5.48xlarge").Near(lat, lng, 2000).Exec()
+	zone.Offers("Standard_ND96isr_H100_v5")
+
+Offerings in Local and Wavelength Zones count for those zones only, not for
+their parent regions.
+
+# Prices
+
+A price table gives each region a PriceIndex relative to a reference region.
+The package ships approximate AWS ratios; install current data with UsePriceTable:
+
+	where.NewQuery().OnAWS().MaxPriceIndex(1.2).SortByPrice().Exec()
+	regions.SortByDistanceAndPrice(lat, lng, 2000) // 10% cheaper = 200 km closer
+
+# Carbon
+
+A carbon table gives regions a CarbonProfile: grid intensity in gCO2eq/kWh,
+provider-reported carbon-free energy and PUE. The package ships approximate
+Google Cloud figures; install others with UseCarbonTable:
+
+	where.NewQuery().MaxCarbonIntensity(100).SortByCarbon().Exec()
+	region, err := where.GreenestNear(lat, lng, 1500)
+
+# Lifecycle
+
+Regions carry lifecycle dates besides LaunchDate, so the catalog can be viewed
+at any point in time:
+
+	where.AsOf(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))   // regions available then
+	ours.RetiringBetween(from, to)                            // retirement dates in [from, to)
+	region.Timeline()                                         // dated lifecycle events
+
+Only milestones the providers have published are recorded. Every region has
+a LaunchDate, but deprecation notices and retirement dates exist only where a
+provider announced them; regions deprecated without a published date, like
+DigitalOcean's legacy nyc2, sfo1 and ams2, carry the Deprecated status alone.
+The only retirement recorded is that of Azure China East and China North on
+1 July 2026; no later retirement of a catalogued region has been announced,
+so RetiringBetween over future dates stays empty until one is.
+
+# Encoding
+
+Regions and zones encode to JSON in a versioned schema: statuses and zone kinds
+are written by name ("opt-in", "local-zone") and every document carries a
+"version" field, SchemaVersion. Numeric statuses from earlier versions still
+decode. Region and Zone also implement the YAML marshaler hooks and gob, so
+catalog snapshots round-trip losslessly:
+
+	data, _ := json.Marshal(where.On.AWS())
+	var regions where.Set
+	err := json.Unmarshal(data, &regions)
+
+# Flags, Config and Databases
+
+Code and RegionID implement flag.Value, sql.Scanner and driver.Valuer,
+validating flags and database columns against the catalog as they are read.
+Unknown values fail with a *NotFoundError carrying suggestions. Text, JSON and
+YAML decoding is lossless instead, so documents naming regions outside the
+catalog round-trip; validate decoded config with ParseCode or ParseRegionID:
+
+	region := where.Flag(nil, "region", "us-east-1", "region to deploy to")
+	id, err := where.ParseRegionID(os.Getenv("PRIMARY_REGION"))
+
+# Telemetry and Kubernetes
+
+Regions and zones map to OpenTelemetry resource attributes and Kubernetes
+topology labels, and back. Provider names translate to the semantic
+convention values, e.g. "alibaba_cloud":
+
+	attrs := region.OTelAttributes("aws_eks")    // cloud.provider, cloud.region, ...
+	labels := zone.KubernetesLabels()             // topology.kubernetes.io/region, zone
+	region, err := where.FromKubernetesLabels(node.Labels)
+
+# Detecting the Current Region
+
+Current reads the region the process runs in from environment variables such
+as AWS_REGION, GOOGLE_CLOUD_REGION, REGION_NAME or FLY_REGION. A Detector can
+also ask the AWS (IMDSv2), Google Cloud and Azure instance metadata services,
+each request bounded by a timeout:
+
+	region, err := where.Current(ctx)
+	region, err = where.Detector{Metadata: true, Timeout: 500 * time.Millisecond}.Detect(ctx)
+
+# Infrastructure as Code
+
+A Set exports as a lookup table keyed by provider and code, with each region's
+name, zones, coordinates and closest region on every other provider in the
+set. It can be written as Terraform/OpenTofu locals, a Terraform JSON variable
+file, or plain JSON for Pulumi and Jsonnet:
+
+	regions := where.NewQuery().InContinent("Europe").ActiveOnly().Exec()
+	regions.WriteHCLLocals(w, "regions")         // locals { regions = { aws = { ... } } }
+	regions.WriteTFVarsJSON(w, "regions")        // regions.auto.tfvars.json
+	regions.WriteIaCJSON(w)
+
+The package ships no command-line tool; run the exports from a small Go
+program, for instance behind go generate, to keep the files in step with the
+catalog.
+
+# Maps
+
+A Set exports as a GeoJSON FeatureCollection or a KML document, and renders as
+a standalone SVG world map with markers colored by provider or status and
+optional great-circle links, split at the antimeridian:
+
+	data, err := ours.GeoJSON()
+	err = ours.WriteKML(w)
+	err = ours.WriteSVG(w, where.MapOptions{
+		Title: "Where we are deployed",
+		Links: []where.Link{{From: "aws/eu-west-1", To: "gcp/us-east4"}},
+	})
+
+# Metros
+
+ClusterBy groups co-located regions across providers, merging the clusters
+with the closest centroids until none are within the radius. Metros clusters
+at MetroRadiusKm, which exposes "multi-cloud" setups that share a campus:
+
+	for _, metro := range where.Metros() {
+		fmt.Println(metro.Name, metro.Providers(), metro.Regions.Codes())
+	}
+
+# Shapes
+
+Besides Near's circles, regions can be selected by bounding box or polygon.
+Both handle the antimeridian, and polygons may have holes and islands.
+Built-in areas cover regulatory and latency domains such as "eu", "nordics"
+and "gcc":
+
+	where.NewQuery().WithinBBox(35, -10, 70, 40).Exec()
+	where.NewQuery().WithinPolygon(where.Polygon{{Lat: 60, Lng: 5}, ...}).Exec()
+	where.NewQuery().WithinArea("eu").ActiveOnly().Exec()
+
+# Cities
+
+NearCity and Proximity.City accept any major city, not just region cities:
+names are resolved against the catalog first, then against an embedded
+world-cities dataset (cities above 100,000 inhabitants and all national
+capitals, with ISO country codes). Names shared by several cities resolve
+to the most populous; a country code picks another:
+
+	where.NewQuery().NearCity("Warsaw", 800).Exec()
+	where.Proximity.City("Lagos", 2000)
+	city, err := where.FindCity("London, CA") // London, Ontario
+	city.Closest(where.OnProvider("aws"))
+
+# Footprints
+
+Score measures how a set of regions serves a population of users, given as
+weighted points or as weights per country code: the share of users within a
+distance of a region, the weighted mean distance, each region's share and
+the uncovered points. Recommend greedily picks the next regions to add, and
+RTTDistance turns a latency budget into a distance:
+
+	users, err := where.CountryPopulation(map[string]float64{"US": 50, "DE": 30, "JP": 20})
+	f := where.Score(where.InCountry("United States"), users, where.RTTDistance(30*time.Millisecond))
+	fmt.Println(f.Served, f.MeanDistanceKm, f.Gaps)
+	next := where.Recommend(current, where.OnProvider("aws"), users, 1500, 3)
+
+# Routing Tables
+
+Assign maps points, or countries via CountryPoints, to their nearest region
+of a set with failover regions in order of distance, optionally within
+per-region capacities. The result exports as a country to regions table for
+DNS geo-steering, and the set is left untouched:
+
+	countries, err := where.CountryPoints(map[string]float64{"DE": 1, "JP": 1, "BR": 1})
+	table := where.Assign(countries, where.OnProvider("aws"), where.AssignOptions{})
+	table.WriteJSON(os.Stdout) // {"BR": ["aws/sa-east-1", ...], ...}
+	table.WriteCSV(os.Stdout)  // point,primary,failover_1,failover_2
+
+# Search
+
+Search ranks regions by how well their code, name, city or country match free
+text. Matching ignores case, accents and punctuation and tolerates typos:
+
+	where.Search("Sao Paulo")                 // []Match, best first
+	where.Suggest("us-eest-1", 3)             // closest regions as a Set
+	where.InCity("Frankfurt am Main")         // city and country aliases resolve
+
 # Builder Pattern Queries
 
 For complex filtering requirements, use the Query builder:
@@ -160,6 +428,9 @@ Each region contains comprehensive metadata:
 		Longitude  float64   // Geographic longitude
 		Status     Status    // Operational status
 		LaunchDate time.Time // When the region became available
+		Zones      []string  // Availability zones
+		Partition  Partition // Cloud environment (commercial, aws-us-gov, ...)
+		Lifecycle  Lifecycle // Announcement, preview, deprecation and retirement dates
 	}
 
 Region status values:
@@ -167,6 +438,15 @@ Region status values:
 	Active     // Fully operational and available
 	Deprecated // Being phased out
 	Preview    // Limited availability
+	OptIn      // Available once an account opts in (AWS af-south-1, ...)
+	Restricted // Available to approved customers or through a partner
+	Announced  // Announced, not yet launched
+	Retired    // Shut down
+
+Filter to the regions an account can actually use with UsableBy:
+
+	caps := where.Capabilities{OptedIn: []where.RegionID{"aws/af-south-1"}}
+	where.NewQuery().OnAWS().UsableBy(caps).Exec()
 
 # Set Operations
 
@@ -209,6 +489,14 @@ Use errors.Is() for error checking:
 		// Handle unknown region
 	}
 
+Lookups of unknown codes return a *NotFoundError carrying "did you mean"
+suggestions; it still matches ErrRegionNotFound:
+
+	var nf *where.NotFoundError
+	if errors.As(err, &nf) {
+		fmt.Println(nf.Suggestions().Codes())
+	}
+
 # Performance Characteristics
 
   - Provider constants: Zero allocations, sub-nanosecond access
@@ -220,7 +508,8 @@ Use errors.Is() for error checking:
 # Thread Safety
 
 All package functions and types are safe for concurrent use across multiple goroutines.
-The internal region registry is read-only after package initialization.
+The internal region registry is read-only after package initialization. Optional
+datasets such as the service matrix are immutable and swapped atomically.
 
 # Examples
 
@@ -0,0 +1,329 @@
+package where
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// SchemaVersion is the version of the JSON and YAML encodings of Region and
+// Zone. Every encoded region and zone carries it in a "version" field; a Set
+// encodes as an array of regions. Decoders treat a missing version as 1 and
+// reject versions newer than they understand.
+const SchemaVersion = 1
+
+// statusNames lists the encoded names of each Status.
+var statusNames = map[Status]string{
+	Active:     "active",
+	Deprecated: "deprecated",
+	Preview:    "preview",
+	OptIn:      "opt-in",
+	Restricted: "restricted",
+	Announced:  "announced",
+	Retired:    "retired",
+}
+
+// ParseStatus returns the Status with the given name, e.g. "opt-in".
+// Numeric values written by earlier versions of the package are accepted too.
+func ParseStatus(name string) (Status, error) {
+	for s, n := range statusNames {
+		if n == name {
+			return s, nil
+		}
+	}
+	if n, err := strconv.ParseUint(name, 10, 8); err == nil {
+		if _, ok := statusNames[Status(n)]; ok {
+			return Status(n), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown status %q", name)
+}
+
+// MarshalText encodes the status as its name.
+func (s Status) MarshalText() ([]byte, error) {
+	name, ok := statusNames[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown status %d", uint8(s))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes a status name; see ParseStatus.
+func (s *Status) UnmarshalText(text []byte) error {
+	status, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
+// UnmarshalJSON decodes a status name, or a number as written by earlier
+// versions of the package.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		name = string(data)
+	}
+	return s.UnmarshalText([]byte(name))
+}
+
+// zoneKindNames lists the encoded names of each ZoneKind.
+var zoneKindNames = map[ZoneKind]string{
+	AvailabilityZone: "availability-zone",
+	LocalZone:        "local-zone",
+	WavelengthZone:   "wavelength-zone",
+}
+
+// MarshalText encodes the zone kind as its name.
+func (k ZoneKind) MarshalText() ([]byte, error) {
+	name, ok := zoneKindNames[k]
+	if !ok {
+		return nil, fmt.Errorf("unknown zone kind %d", uint8(k))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes a zone kind name, or a number as written by earlier
+// versions of the package.
+func (k *ZoneKind) UnmarshalText(text []byte) error {
+	for kind, name := range zoneKindNames {
+		if name == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+	if n, err := strconv.ParseUint(string(text), 10, 8); err == nil {
+		if _, ok := zoneKindNames[ZoneKind(n)]; ok {
+			*k = ZoneKind(n)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown zone kind %q", text)
+}
+
+// UnmarshalJSON decodes a zone kind name or number.
+func (k *ZoneKind) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		name = string(data)
+	}
+	return k.UnmarshalText([]byte(name))
+}
+
+// regionDoc is the versioned wire form of a Region.
+type regionDoc struct {
+	Version    int       `json:"version" yaml:"version"`
+	Code       Code      `json:"code" yaml:"code"`
+	Name       string    `json:"name" yaml:"name"`
+	Provider   string    `json:"provider" yaml:"provider"`
+	Country    string    `json:"country" yaml:"country"`
+	City       string    `json:"city" yaml:"city"`
+	Continent  string    `json:"continent" yaml:"continent"`
+	Latitude   float64   `json:"latitude" yaml:"latitude"`
+	Longitude  float64   `json:"longitude" yaml:"longitude"`
+	Status     Status    `json:"status" yaml:"status"`
+	LaunchDate time.Time `json:"launch_date" yaml:"launch_date"`
+	Zones      []string  `json:"zones" yaml:"zones"`
+	Partition  Partition `json:"partition" yaml:"partition"`
+	Lifecycle  Lifecycle `json:"lifecycle" yaml:"lifecycle"`
+}
+
+// doc returns the wire form of the region.
+func (r Region) doc() regionDoc {
+	return regionDoc{
+		Version:    SchemaVersion,
+		Code:       r.Code,
+		Name:       r.Name,
+		Provider:   r.Provider,
+		Country:    r.Country,
+		City:       r.City,
+		Continent:  r.Continent,
+		Latitude:   r.Latitude,
+		Longitude:  r.Longitude,
+		Status:     r.Status,
+		LaunchDate: r.LaunchDate,
+		Zones:      r.Zones,
+		Partition:  r.Partition,
+		Lifecycle:  r.Lifecycle,
+	}
+}
+
+// region returns the region described by the wire form.
+func (d regionDoc) region() (Region, error) {
+	if d.Version > SchemaVersion {
+		return Region{}, fmt.Errorf("unsupported region schema version %d", d.Version)
+	}
+	return Region{
+		Code:       d.Code,
+		Name:       d.Name,
+		Provider:   d.Provider,
+		Country:    d.Country,
+		City:       d.City,
+		Continent:  d.Continent,
+		Latitude:   d.Latitude,
+		Longitude:  d.Longitude,
+		Status:     d.Status,
+		LaunchDate: d.LaunchDate,
+		Zones:      d.Zones,
+		Partition:  d.Partition,
+		Lifecycle:  d.Lifecycle,
+	}, nil
+}
+
+// MarshalJSON encodes the region in the versioned schema.
+func (r Region) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.doc())
+}
+
+// UnmarshalJSON decodes a region in the versioned schema.
+func (r *Region) UnmarshalJSON(data []byte) error {
+	var d regionDoc
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	region, err := d.region()
+	if err != nil {
+		return err
+	}
+	*r = region
+	return nil
+}
+
+// MarshalYAML encodes the region in the versioned schema. It implements the
+// Marshaler interface of the common YAML packages without depending on one.
+func (r Region) MarshalYAML() (interface{}, error) {
+	return r.doc(), nil
+}
+
+// UnmarshalYAML decodes a region in the versioned schema. It implements the
+// legacy Unmarshaler interface that the common YAML packages still honor.
+func (r *Region) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d regionDoc
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	region, err := d.region()
+	if err != nil {
+		return err
+	}
+	*r = region
+	return nil
+}
+
+// GobEncode encodes the region for encoding/gob. Gob drops empty slices, so the
+// payload is the JSON encoding, which keeps them.
+func (r Region) GobEncode() ([]byte, error) {
+	return r.MarshalJSON()
+}
+
+// GobDecode decodes a region encoded by GobEncode.
+func (r *Region) GobDecode(data []byte) error {
+	return r.UnmarshalJSON(data)
+}
+
+// zoneDoc is the versioned wire form of a Zone.
+type zoneDoc struct {
+	Version   int      `json:"version" yaml:"version"`
+	Name      string   `json:"name" yaml:"name"`
+	Kind      ZoneKind `json:"kind" yaml:"kind"`
+	Provider  string   `json:"provider" yaml:"provider"`
+	Region    Code     `json:"region" yaml:"region"`
+	Group     string   `json:"group" yaml:"group"`
+	Country   string   `json:"country" yaml:"country"`
+	City      string   `json:"city" yaml:"city"`
+	Continent string   `json:"continent" yaml:"continent"`
+	Latitude  float64  `json:"latitude" yaml:"latitude"`
+	Longitude float64  `json:"longitude" yaml:"longitude"`
+	OptIn     bool     `json:"opt_in" yaml:"opt_in"`
+	Carrier   string   `json:"carrier,omitempty" yaml:"carrier,omitempty"`
+}
+
+// doc returns the wire form of the zone.
+func (z Zone) doc() zoneDoc {
+	return zoneDoc{
+		Version:   SchemaVersion,
+		Name:      z.Name,
+		Kind:      z.Kind,
+		Provider:  z.Provider,
+		Region:    z.Region,
+		Group:     z.Group,
+		Country:   z.Country,
+		City:      z.City,
+		Continent: z.Continent,
+		Latitude:  z.Latitude,
+		Longitude: z.Longitude,
+		OptIn:     z.OptIn,
+		Carrier:   z.Carrier,
+	}
+}
+
+// zone returns the zone described by the wire form.
+func (d zoneDoc) zone() (Zone, error) {
+	if d.Version > SchemaVersion {
+		return Zone{}, fmt.Errorf("unsupported zone schema version %d", d.Version)
+	}
+	return Zone{
+		Name:      d.Name,
+		Kind:      d.Kind,
+		Provider:  d.Provider,
+		Region:    d.Region,
+		Group:     d.Group,
+		Country:   d.Country,
+		City:      d.City,
+		Continent: d.Continent,
+		Latitude:  d.Latitude,
+		Longitude: d.Longitude,
+		OptIn:     d.OptIn,
+		Carrier:   d.Carrier,
+	}, nil
+}
+
+// MarshalJSON encodes the zone in the versioned schema.
+func (z Zone) MarshalJSON() ([]byte, error) {
+	return json.Marshal(z.doc())
+}
+
+// UnmarshalJSON decodes a zone in the versioned schema.
+func (z *Zone) UnmarshalJSON(data []byte) error {
+	var d zoneDoc
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	zone, err := d.zone()
+	if err != nil {
+		return err
+	}
+	*z = zone
+	return nil
+}
+
+// MarshalYAML encodes the zone in the versioned schema.
+func (z Zone) MarshalYAML() (interface{}, error) {
+	return z.doc(), nil
+}
+
+// UnmarshalYAML decodes a zone in the versioned schema.
+func (z *Zone) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var d zoneDoc
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+	zone, err := d.zone()
+	if err != nil {
+		return err
+	}
+	*z = zone
+	return nil
+}
+
+// GobEncode encodes the zone for encoding/gob.
+func (z Zone) GobEncode() ([]byte, error) {
+	return z.MarshalJSON()
+}
+
+// GobDecode decodes a zone encoded by GobEncode.
+func (z *Zone) GobDecode(data []byte) error {
+	return z.UnmarshalJSON(data)
+}
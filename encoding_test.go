@@ -0,0 +1,149 @@
+package where
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStatusText(t *testing.T) {
+	for status := range statusNames {
+		text, err := status.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%d) failed: %v", status, err)
+		}
+		var got Status
+		if err := got.UnmarshalText(text); err != nil || got != status {
+			t.Errorf("UnmarshalText(%s) = %v, %v, want %v", text, got, err, status)
+		}
+	}
+
+	var s Status
+	if err := json.Unmarshal([]byte(`2`), &s); err != nil || s != Preview {
+		t.Errorf("legacy numeric status decoded as %v, %v, want preview", s, err)
+	}
+	if err := json.Unmarshal([]byte(`"opt-in"`), &s); err != nil || s != OptIn {
+		t.Errorf("status name decoded as %v, %v, want opt-in", s, err)
+	}
+	if err := json.Unmarshal([]byte(`"sideways"`), &s); err == nil {
+		t.Error("unknown status names should fail to decode")
+	}
+	if _, err := Status(200).MarshalText(); err == nil {
+		t.Error("MarshalText() should fail for unknown statuses")
+	}
+}
+
+func TestRegionJSONSchema(t *testing.T) {
+	data, err := json.Marshal(MustIs("af-south-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"version":1`, `"status":"opt-in"`, `"code":"af-south-1"`, `"launch_date":"2020-`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Region JSON %s does not contain %s", data, want)
+		}
+	}
+
+	var r Region
+	if err := json.Unmarshal([]byte(`{"version": 99, "code": "x"}`), &r); err == nil {
+		t.Error("newer schema versions should be rejected")
+	}
+	legacy := `{"code": "us-east-1", "provider": "aws", "status": 1}`
+	if err := json.Unmarshal([]byte(legacy), &r); err != nil || r.Status != Deprecated {
+		t.Errorf("legacy region decoded as %+v, %v", r, err)
+	}
+
+	zone, err := FindZone("us-west-2-lax-1a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = json.Marshal(zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"kind":"local-zone"`) || !strings.Contains(string(data), `"version":1`) {
+		t.Errorf("Zone JSON %s should carry version and kind name", data)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	regions := allRegions()
+	regions = append(regions, Region{Code: "custom-1", Provider: "onprem", Status: Announced})
+	zones := append(ExtendedZones(), MustIs("us-east-1").AvailabilityZones()...)
+
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(regions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Set
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, regions) {
+			t.Error("Set did not round-trip through JSON")
+		}
+
+		data, err = json.Marshal(zones)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotZones ZoneSet
+		if err := json.Unmarshal(data, &gotZones); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(gotZones, zones) {
+			t.Error("ZoneSet did not round-trip through JSON")
+		}
+	})
+
+	t.Run("gob", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(regions); err != nil {
+			t.Fatal(err)
+		}
+		if err := gob.NewEncoder(&buf).Encode(zones); err != nil {
+			t.Fatal(err)
+		}
+		dec := gob.NewDecoder(&buf)
+		var got Set
+		var gotZones ZoneSet
+		if err := dec.Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if err := dec.Decode(&gotZones); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, regions) || !reflect.DeepEqual(gotZones, zones) {
+			t.Error("regions and zones did not round-trip through gob")
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		// YAML packages call MarshalYAML and hand UnmarshalYAML a decode
+		// function for the produced value; JSON stands in for the document.
+		for _, r := range regions {
+			v, err := r.MarshalYAML()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got Region
+			err = got.UnmarshalYAML(func(out interface{}) error {
+				data, err := json.Marshal(v)
+				if err != nil {
+					return err
+				}
+				return json.Unmarshal(data, out)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, r) {
+				t.Errorf("%v did not round-trip through the YAML hooks", r.ID())
+			}
+		}
+	})
+}
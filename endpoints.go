@@ -0,0 +1,167 @@
+package where
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EndpointVariant selects an alternative form of a service endpoint.
+// Variants combine, e.g. FIPS|DualStack.
+type EndpointVariant uint8
+
+const (
+	// FIPS selects endpoints that use FIPS 140 validated cryptography.
+	FIPS EndpointVariant = 1 << iota
+	// DualStack selects endpoints reachable over both IPv4 and IPv6.
+	DualStack
+)
+
+// String returns the variant name, e.g. "fips+dualstack".
+func (v EndpointVariant) String() string {
+	switch v {
+	case 0:
+		return "standard"
+	case FIPS:
+		return "fips"
+	case DualStack:
+		return "dualstack"
+	case FIPS | DualStack:
+		return "fips+dualstack"
+	default:
+		return "unknown"
+	}
+}
+
+// awsPartitionDNS holds the DNS suffixes of each AWS partition.
+var awsPartitionDNS = map[Partition]struct {
+	DNSSuffix          string
+	DualStackDNSSuffix string
+}{
+	PartitionCommercial:           {"amazonaws.com", "api.aws"},
+	PartitionAWSGovCloud:          {"amazonaws.com", "api.aws"},
+	PartitionAWSChina:             {"amazonaws.com.cn", "api.amazonwebservices.com.cn"},
+	PartitionAWSEuropeanSovereign: {"amazonaws.eu", "api.amazonwebservices.eu"},
+}
+
+// azureServiceDomains maps Azure services with regional hostnames to the
+// domain they use in each Azure cloud. The region is the leftmost label,
+// e.g. "swedencentral.monitoring.azure.com".
+var azureServiceDomains = map[string]map[Partition]string{
+	"batch": {
+		PartitionCommercial:      "batch.azure.com",
+		PartitionAzureGovernment: "batch.usgovcloudapi.net",
+		PartitionAzureChina:      "batch.chinacloudapi.cn",
+	},
+	"cognitiveservices": {
+		PartitionCommercial:      "api.cognitive.microsoft.com",
+		PartitionAzureGovernment: "api.cognitive.microsoft.us",
+		PartitionAzureChina:      "api.cognitive.azure.cn",
+	},
+	"monitoring": {
+		PartitionCommercial:      "monitoring.azure.com",
+		PartitionAzureGovernment: "monitoring.azure.us",
+		PartitionAzureChina:      "monitoring.azure.cn",
+	},
+}
+
+// Endpoint returns the hostname of a regional service endpoint, e.g.
+// "s3.eu-west-1.amazonaws.com" for Endpoint("s3") on AWS eu-west-1. Pass FIPS
+// and/or DualStack to select those variants. Service names follow each
+// provider's hostname convention ("ec2", "run", "ecs", "cvm"); Azure supports
+// the services in azureServiceDomains and DigitalOcean only "spaces".
+// Unsupported providers, services and variants return ErrEndpointNotFound.
+func (r Region) Endpoint(service string, variants ...EndpointVariant) (string, error) {
+	var variant EndpointVariant
+	for _, v := range variants {
+		variant |= v
+	}
+	service = strings.ToLower(strings.TrimSpace(service))
+	if service == "" {
+		return "", fmt.Errorf("%w: empty service name", ErrEndpointNotFound)
+	}
+
+	if r.Provider == ProviderAWS {
+		return awsEndpoint(r, service, variant)
+	}
+	if variant != 0 {
+		return "", fmt.Errorf("%w: %s endpoints are not available in %s", ErrEndpointNotFound, variant, r.ID())
+	}
+
+	switch r.Provider {
+	case ProviderAzure:
+		domain, ok := azureServiceDomains[service][r.Partition]
+		if !ok {
+			return "", fmt.Errorf("%w: no regional %s endpoint in %s", ErrEndpointNotFound, service, r.ID())
+		}
+		return string(r.Code) + "." + domain, nil
+	case ProviderGCP:
+		if r.IsIsolated() {
+			return "", fmt.Errorf("%w: %s is operated by a partner with its own API domains", ErrEndpointNotFound, r.ID())
+		}
+		if service == "docker" {
+			return string(r.Code) + "-docker.pkg.dev", nil
+		}
+		return string(r.Code) + "-" + service + ".googleapis.com", nil
+	case ProviderAlibaba:
+		if service == "oss" {
+			return "oss-" + string(r.Code) + ".aliyuncs.com", nil
+		}
+		return service + "." + string(r.Code) + ".aliyuncs.com", nil
+	case ProviderTencent:
+		if service == "cos" {
+			return "cos." + string(r.Code) + ".myqcloud.com", nil
+		}
+		return service + "." + string(r.Code) + ".tencentcloudapi.com", nil
+	case ProviderHuawei:
+		if r.Code == Huawei.EUWest101 {
+			return service + "." + string(r.Code) + ".myhuaweicloud.eu", nil
+		}
+		return service + "." + string(r.Code) + ".myhuaweicloud.com", nil
+	case ProviderDigitalOcean:
+		if service != "spaces" {
+			return "", fmt.Errorf("%w: no regional %s endpoint in %s", ErrEndpointNotFound, service, r.ID())
+		}
+		return string(r.Code) + ".digitaloceanspaces.com", nil
+	}
+	return "", fmt.Errorf("%w: no endpoint templates for provider %s", ErrEndpointNotFound, r.Provider)
+}
+
+// awsEndpoint builds an AWS endpoint using the DNS suffixes of the region's partition.
+func awsEndpoint(r Region, service string, variant EndpointVariant) (string, error) {
+	dns, ok := awsPartitionDNS[r.Partition]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown partition %q", ErrEndpointNotFound, r.Partition)
+	}
+	// FIPS endpoints exist only in GovCloud and the US and Canadian commercial regions.
+	if variant&FIPS != 0 && r.Partition != PartitionAWSGovCloud &&
+		!(r.Partition == PartitionCommercial && (r.Country == "United States" || r.Country == "Canada")) {
+		return "", fmt.Errorf("%w: %s endpoints are not available in %s", ErrEndpointNotFound, variant, r.ID())
+	}
+
+	name := service
+	if variant&FIPS != 0 {
+		name += "-fips"
+	}
+	switch {
+	case variant&DualStack == 0:
+		return name + "." + string(r.Code) + "." + dns.DNSSuffix, nil
+	case service == "s3":
+		// S3 predates the api.aws domain and keeps its own dual-stack form.
+		return name + ".dualstack." + string(r.Code) + "." + dns.DNSSuffix, nil
+	default:
+		return name + "." + string(r.Code) + "." + dns.DualStackDNSSuffix, nil
+	}
+}
+
+// Endpoints returns the endpoint hostname of a service in every region of the
+// set that has one, e.g. for building firewall allow-lists. Regions without
+// the endpoint are skipped.
+func (s Set) Endpoints(service string, variants ...EndpointVariant) []string {
+	hosts := make([]string, 0, len(s))
+	for _, region := range s {
+		if host, err := region.Endpoint(service, variants...); err == nil {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
@@ -0,0 +1,111 @@
+package where
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRegionEndpoint(t *testing.T) {
+	tests := []struct {
+		id       RegionID
+		service  string
+		variants []EndpointVariant
+		expected string
+	}{
+		{"aws/eu-west-1", "s3", nil, "s3.eu-west-1.amazonaws.com"},
+		{"aws/cn-north-1", "s3", nil, "s3.cn-north-1.amazonaws.com.cn"},
+		{"aws/us-east-1", "ec2", []EndpointVariant{FIPS}, "ec2-fips.us-east-1.amazonaws.com"},
+		{"aws/us-east-1", "ec2", []EndpointVariant{DualStack}, "ec2.us-east-1.api.aws"},
+		{"aws/us-east-1", "ec2", []EndpointVariant{FIPS, DualStack}, "ec2-fips.us-east-1.api.aws"},
+		{"aws/us-west-2", "s3", []EndpointVariant{DualStack}, "s3.dualstack.us-west-2.amazonaws.com"},
+		{"aws/us-gov-west-1", "s3", []EndpointVariant{FIPS | DualStack}, "s3-fips.dualstack.us-gov-west-1.amazonaws.com"},
+		{"aws/cn-north-1", "ec2", []EndpointVariant{DualStack}, "ec2.cn-north-1.api.amazonwebservices.com.cn"},
+		{"aws/eusc-de-east-1", "sqs", nil, "sqs.eusc-de-east-1.amazonaws.eu"},
+		{"azure/swedencentral", "monitoring", nil, "swedencentral.monitoring.azure.com"},
+		{"azure/usgovvirginia", "batch", nil, "usgovvirginia.batch.usgovcloudapi.net"},
+		{"azure/swedencentral", "cognitiveservices", nil, "swedencentral.api.cognitive.microsoft.com"},
+		{"azure/usgovvirginia", "cognitiveservices", nil, "usgovvirginia.api.cognitive.microsoft.us"},
+		{"azure/chinanorth3", "cognitiveservices", nil, "chinanorth3.api.cognitive.azure.cn"},
+		{"gcp/europe-west1", "run", nil, "europe-west1-run.googleapis.com"},
+		{"gcp/us-central1", "docker", nil, "us-central1-docker.pkg.dev"},
+		{"alibaba/cn-hangzhou", "ECS", nil, "ecs.cn-hangzhou.aliyuncs.com"},
+		{"alibaba/cn-hangzhou", "oss", nil, "oss-cn-hangzhou.aliyuncs.com"},
+		{"tencent/ap-guangzhou", "cvm", nil, "cvm.ap-guangzhou.tencentcloudapi.com"},
+		{"huawei/eu-west-101", "ecs", nil, "ecs.eu-west-101.myhuaweicloud.eu"},
+		{"digitalocean/fra1", "spaces", nil, "fra1.digitaloceanspaces.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.id)+"/"+tt.service, func(t *testing.T) {
+			region, err := ByID(tt.id)
+			if err != nil {
+				t.Fatalf("ByID(%q) failed: %v", tt.id, err)
+			}
+			host, err := region.Endpoint(tt.service, tt.variants...)
+			if err != nil {
+				t.Fatalf("Endpoint() failed: %v", err)
+			}
+			if host != tt.expected {
+				t.Errorf("Endpoint() = %v, want %v", host, tt.expected)
+			}
+
+			parsed, err := FromEndpoint(host)
+			if err != nil {
+				t.Fatalf("FromEndpoint(%q) failed: %v", host, err)
+			}
+			if parsed.ID() != tt.id {
+				t.Errorf("FromEndpoint(%q) = %v, want %v", host, parsed.ID(), tt.id)
+			}
+		})
+	}
+}
+
+func TestRegionEndpointUnsupported(t *testing.T) {
+	tests := []struct {
+		id       RegionID
+		service  string
+		variants []EndpointVariant
+	}{
+		{"aws/eu-west-1", "ec2", []EndpointVariant{FIPS}},
+		{"aws/cn-north-1", "s3", []EndpointVariant{FIPS}},
+		{"aws/us-east-1", " ", nil},
+		{"gcp/europe-west1", "run", []EndpointVariant{DualStack}},
+		{"gcp/u-france-east1", "run", nil},
+		{"azure/swedencentral", "virtualmachines", nil},
+		{"digitalocean/fra1", "droplets", nil},
+		{"fly/cdg", "machines", nil},
+	}
+
+	for _, tt := range tests {
+		region, err := ByID(tt.id)
+		if err != nil {
+			t.Fatalf("ByID(%q) failed: %v", tt.id, err)
+		}
+		if host, err := region.Endpoint(tt.service, tt.variants...); !errors.Is(err, ErrEndpointNotFound) {
+			t.Errorf("%v.Endpoint(%q) = %q, %v, want ErrEndpointNotFound", tt.id, tt.service, host, err)
+		}
+	}
+}
+
+func TestSetEndpoints(t *testing.T) {
+	regions := InCountry("Canada")
+	hosts := regions.Endpoints("s3", FIPS)
+	aws := regions.OnProvider(ProviderAWS)
+	if len(aws) == 0 || len(hosts) != len(aws) {
+		t.Errorf("Endpoints() returned %d hosts, want one per AWS region (%d)", len(hosts), len(aws))
+	}
+}
+
+func TestEndpointVariantString(t *testing.T) {
+	tests := map[EndpointVariant]string{
+		0:                "standard",
+		FIPS:             "fips",
+		DualStack:        "dualstack",
+		FIPS | DualStack: "fips+dualstack",
+	}
+	for v, want := range tests {
+		if got := v.String(); got != want {
+			t.Errorf("String() = %v, want %v", got, want)
+		}
+	}
+}
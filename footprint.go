@@ -0,0 +1,263 @@
+package where
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Rules of thumb behind RTTDistance and EstimateRTT: light covers about
+// 200 km per millisecond in fiber, and fiber routes run about 1.5 times the
+// great-circle distance.
+const (
+	fiberKmPerMs   = 200
+	routeInflation = 1.5
+)
+
+// EstimateRTT estimates the network round-trip time over a great-circle
+// distance in kilometers. It is a floor set by physics and typical routing,
+// not a measurement: queuing and last-mile delays come on top.
+func EstimateRTT(km float64) time.Duration {
+	ms := 2 * km * routeInflation / fiberKmPerMs
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// RTTDistance is the inverse of EstimateRTT: the great-circle distance in
+// kilometers reachable within a round-trip latency budget, for use as the
+// threshold of Score and Recommend.
+func RTTDistance(rtt time.Duration) float64 {
+	ms := float64(rtt) / float64(time.Millisecond)
+	return ms * fiberKmPerMs / (2 * routeInflation)
+}
+
+// PopulationPoint is a weighted location of users, e.g. a city with its
+// share of traffic.
+type PopulationPoint struct {
+	Label     string  `json:"label,omitempty"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Weight    float64 `json:"weight"`
+}
+
+// Population is a distribution of users over the world.
+type Population []PopulationPoint
+
+// CountryPopulation builds a population from per-country weights keyed by
+// ISO 3166-1 alpha-2 code, e.g. {"US": 60, "DE": 25, "JP": 15}. Each
+// country's weight is spread over its cities in the embedded world-cities
+// dataset in proportion to their inhabitants.
+func CountryPopulation(weights map[string]float64) (Population, error) {
+	countries := make([]string, 0, len(weights))
+	for country := range weights {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+
+	var p Population
+	for _, country := range countries {
+		cities := CitiesIn(country)
+		if len(cities) == 0 {
+			return nil, fmt.Errorf("unknown country code %q", country)
+		}
+		var total float64
+		for _, c := range cities {
+			total += float64(c.Population)
+		}
+		for _, c := range cities {
+			p = append(p, PopulationPoint{
+				Label:     c.Name + ", " + strings.ToUpper(country),
+				Latitude:  c.Latitude,
+				Longitude: c.Longitude,
+				Weight:    weights[country] * float64(c.Population) / total,
+			})
+		}
+	}
+	return p, nil
+}
+
+// TotalWeight returns the sum of the weights of the population.
+func (p Population) TotalWeight() float64 {
+	var total float64
+	for _, pt := range p {
+		total += pt.Weight
+	}
+	return total
+}
+
+// RegionScore describes how one region of a footprint serves the population.
+type RegionScore struct {
+	Region Region `json:"region"`
+	// Reach is the share of users within the threshold of the region,
+	// whether or not another region is nearer.
+	Reach float64 `json:"reach"`
+	// Nearest is the share of users for whom the region is the nearest.
+	Nearest float64 `json:"nearest"`
+	// MeanDistanceKm is the weighted mean distance of the users for whom the
+	// region is the nearest; 0 when there are none.
+	MeanDistanceKm float64 `json:"meanDistanceKm"`
+}
+
+// Gap is a population point farther than the threshold from every region.
+type Gap struct {
+	Point      PopulationPoint `json:"point"`
+	Nearest    RegionID        `json:"nearest,omitempty"` // empty, with a zero distance, for an empty set
+	DistanceKm float64         `json:"distanceKm"`
+}
+
+// Footprint is the result of Score.
+type Footprint struct {
+	ThresholdKm float64 `json:"thresholdKm"`
+	// Served is the share of users within the threshold of some region.
+	Served float64 `json:"served"`
+	// MeanDistanceKm is the weighted mean distance from users to their
+	// nearest region; 0 for an empty set.
+	MeanDistanceKm float64       `json:"meanDistanceKm"`
+	Regions        []RegionScore `json:"regions"` // in the order of the set
+	Gaps           []Gap         `json:"gaps"`    // heaviest first
+}
+
+// Score measures how well the regions of a set serve a population of users:
+// the share of users within thresholdKm of a region, the weighted mean
+// distance to the nearest region, each region's contribution and the
+// population points left uncovered. Use RTTDistance to express the threshold
+// as a latency budget.
+func Score(s Set, p Population, thresholdKm float64) Footprint {
+	f := Footprint{ThresholdKm: thresholdKm, Regions: make([]RegionScore, len(s)), Gaps: []Gap{}}
+	for i, r := range s {
+		f.Regions[i].Region = r
+	}
+	total := p.TotalWeight()
+	if total <= 0 {
+		return f
+	}
+
+	var distanceSum float64
+	for _, pt := range p {
+		nearest, best := -1, math.Inf(1)
+		for i, r := range s {
+			d := haversineDistance(pt.Latitude, pt.Longitude, r.Latitude, r.Longitude)
+			if d <= thresholdKm {
+				f.Regions[i].Reach += pt.Weight
+			}
+			if d < best {
+				nearest, best = i, d
+			}
+		}
+		if nearest < 0 {
+			f.Gaps = append(f.Gaps, Gap{Point: pt})
+			continue
+		}
+		distanceSum += pt.Weight * best
+		f.Regions[nearest].Nearest += pt.Weight
+		f.Regions[nearest].MeanDistanceKm += pt.Weight * best
+		if best <= thresholdKm {
+			f.Served += pt.Weight
+		} else {
+			f.Gaps = append(f.Gaps, Gap{Point: pt, Nearest: s[nearest].ID(), DistanceKm: best})
+		}
+	}
+
+	f.Served /= total
+	if len(s) > 0 {
+		f.MeanDistanceKm = distanceSum / total
+	}
+	for i := range f.Regions {
+		rs := &f.Regions[i]
+		if rs.Nearest > 0 {
+			rs.MeanDistanceKm /= rs.Nearest
+		}
+		rs.Reach /= total
+		rs.Nearest /= total
+	}
+	sort.SliceStable(f.Gaps, func(i, j int) bool {
+		return f.Gaps[i].Point.Weight > f.Gaps[j].Point.Weight
+	})
+	return f
+}
+
+// Recommendation is one step of Recommend: the region to add and the
+// footprint's served share and mean distance once it is added.
+type Recommendation struct {
+	Region         Region  `json:"region"`
+	Served         float64 `json:"served"`
+	MeanDistanceKm float64 `json:"meanDistanceKm"`
+}
+
+// Recommend greedily picks up to n regions from candidates to add to the set,
+// each time the one serving the most additional users within thresholdKm,
+// or on a tie the one cutting the mean distance most. It stops early when no
+// candidate improves the footprint. Candidates already in the set are
+// skipped; neither set is modified.
+func Recommend(s Set, candidates Set, p Population, thresholdKm float64, n int) []Recommendation {
+	total := p.TotalWeight()
+	if total <= 0 {
+		return nil
+	}
+	// best holds each point's distance to its nearest region so far.
+	best := make([]float64, len(p))
+	for i, pt := range p {
+		best[i] = math.Inf(1)
+		for _, r := range s {
+			best[i] = math.Min(best[i], haversineDistance(pt.Latitude, pt.Longitude, r.Latitude, r.Longitude))
+		}
+	}
+	used := make(map[RegionID]bool, len(s))
+	for _, r := range s {
+		used[r.ID()] = true
+	}
+	// dist caches candidate-to-point distances.
+	dist := make([][]float64, len(candidates))
+	for c, r := range candidates {
+		dist[c] = make([]float64, len(p))
+		for i, pt := range p {
+			dist[c][i] = haversineDistance(pt.Latitude, pt.Longitude, r.Latitude, r.Longitude)
+		}
+	}
+
+	var steps []Recommendation
+	for len(steps) < n {
+		pick, bestServed, bestSum := -1, 0.0, 0.0
+		for c, r := range candidates {
+			if used[r.ID()] {
+				continue
+			}
+			var served, sum float64
+			for i, pt := range p {
+				d := math.Min(best[i], dist[c][i])
+				if d <= thresholdKm {
+					served += pt.Weight
+				}
+				sum += pt.Weight * d
+			}
+			if pick < 0 || served > bestServed+1e-9 || (math.Abs(served-bestServed) <= 1e-9 && sum < bestSum) {
+				pick, bestServed, bestSum = c, served, sum
+			}
+		}
+		if pick < 0 || !improves(p, best, dist[pick]) {
+			break
+		}
+		for i := range best {
+			best[i] = math.Min(best[i], dist[pick][i])
+		}
+		used[candidates[pick].ID()] = true
+		steps = append(steps, Recommendation{
+			Region:         candidates[pick],
+			Served:         bestServed / total,
+			MeanDistanceKm: bestSum / total,
+		})
+	}
+	return steps
+}
+
+// improves reports whether a region at the given point distances brings any
+// weighted point closer than its current nearest region.
+func improves(p Population, best, dist []float64) bool {
+	for i, pt := range p {
+		if pt.Weight > 0 && dist[i] < best[i] {
+			return true
+		}
+	}
+	return false
+}
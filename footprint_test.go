@@ -0,0 +1,117 @@
+package where
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRTTDistance(t *testing.T) {
+	if got := EstimateRTT(1000); got != 15*time.Millisecond {
+		t.Errorf("EstimateRTT(1000) = %v, want 15ms", got)
+	}
+	if got := RTTDistance(EstimateRTT(2500)); math.Abs(got-2500) > 1e-6 {
+		t.Errorf("RTTDistance(EstimateRTT(2500)) = %v, want 2500", got)
+	}
+}
+
+func TestCountryPopulation(t *testing.T) {
+	p, err := CountryPopulation(map[string]float64{"DE": 30, "jp": 70})
+	if err != nil {
+		t.Fatalf("CountryPopulation() error = %v", err)
+	}
+	if got := p.TotalWeight(); math.Abs(got-100) > 1e-9 {
+		t.Errorf("TotalWeight() = %v, want 100", got)
+	}
+	for _, pt := range p {
+		if pt.Label == "Berlin, DE" && pt.Weight <= 0 {
+			t.Errorf("Berlin weight = %v", pt.Weight)
+		}
+	}
+	if _, err := CountryPopulation(map[string]float64{"XX": 1}); err == nil {
+		t.Error("CountryPopulation(XX) succeeded, want error")
+	}
+}
+
+func TestScore(t *testing.T) {
+	set := Set{
+		{Code: "fra", Provider: "test", Latitude: 50.1, Longitude: 8.7},
+		{Code: "nrt", Provider: "test", Latitude: 35.7, Longitude: 139.7},
+	}
+	p := Population{
+		{Label: "Berlin", Latitude: 52.5, Longitude: 13.4, Weight: 2},
+		{Label: "Tokyo", Latitude: 35.7, Longitude: 139.7, Weight: 1},
+		{Label: "Sydney", Latitude: -33.9, Longitude: 151.2, Weight: 1},
+	}
+	f := Score(set, p, 1000)
+	if math.Abs(f.Served-0.75) > 1e-9 {
+		t.Errorf("Served = %v, want 0.75", f.Served)
+	}
+	if len(f.Gaps) != 1 || f.Gaps[0].Point.Label != "Sydney" || f.Gaps[0].Nearest != "test/nrt" {
+		t.Errorf("Gaps = %+v, want Sydney nearest to test/nrt", f.Gaps)
+	}
+	if got := f.Regions[0]; math.Abs(got.Reach-0.5) > 1e-9 || math.Abs(got.Nearest-0.5) > 1e-9 {
+		t.Errorf("Regions[0] reach, nearest = %v, %v, want 0.5, 0.5", got.Reach, got.Nearest)
+	}
+	if got := f.Regions[1]; math.Abs(got.Reach-0.25) > 1e-9 || math.Abs(got.Nearest-0.5) > 1e-9 {
+		t.Errorf("Regions[1] reach, nearest = %v, %v, want 0.25, 0.5", got.Reach, got.Nearest)
+	}
+	sydneyKm := haversineDistance(-33.9, 151.2, 35.7, 139.7)
+	if want := sydneyKm / 2; math.Abs(f.Regions[1].MeanDistanceKm-want) > 1e-6 {
+		t.Errorf("Regions[1].MeanDistanceKm = %v, want %v", f.Regions[1].MeanDistanceKm, want)
+	}
+
+	empty := Score(Set{}, p, 1000)
+	if empty.Served != 0 || empty.MeanDistanceKm != 0 || len(empty.Gaps) != 3 {
+		t.Errorf("Score() on empty set = %+v", empty)
+	}
+	if _, err := json.Marshal(empty); err != nil {
+		t.Errorf("json.Marshal(Score()) error = %v", err)
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	p, err := CountryPopulation(map[string]float64{"US": 50, "DE": 30, "JP": 20})
+	if err != nil {
+		t.Fatalf("CountryPopulation() error = %v", err)
+	}
+	aws := OnProvider("aws")
+	start := aws.Filter(func(r Region) bool { return r.Code == "us-east-1" })
+	before := append(Set{}, aws...)
+
+	steps := Recommend(start, aws, p, 1500, 3)
+	if len(steps) != 3 {
+		t.Fatalf("Recommend() = %d steps, want 3", len(steps))
+	}
+	for i, s := range steps {
+		if s.Region.Code == "us-east-1" {
+			t.Errorf("Recommend() step %d re-adds us-east-1", i)
+		}
+		if i > 0 && s.Served < steps[i-1].Served {
+			t.Errorf("Recommend() served share drops at step %d", i)
+		}
+	}
+	// Germany is the largest uncovered market, then Japan.
+	base := Score(start, p, 1500).Served
+	if got := steps[0].Served - base; math.Abs(got-0.3) > 1e-9 {
+		t.Errorf("Recommend() first step = %s, adds %v, want 0.3", steps[0].Region.Code, got)
+	}
+	if got := steps[1].Region.Country; got != "Japan" {
+		t.Errorf("Recommend() second step = %s in %s, want Japan", steps[1].Region.Code, got)
+	}
+	if got := Score(append(start, steps[0].Region), p, 1500).Served; math.Abs(got-steps[0].Served) > 1e-9 {
+		t.Errorf("Recommend() served = %v, Score() = %v", steps[0].Served, got)
+	}
+	for i := range aws {
+		if aws[i].ID() != before[i].ID() {
+			t.Fatal("Recommend() reordered the candidates")
+		}
+	}
+
+	// Once everyone is at zero distance nothing improves the footprint.
+	point := Population{{Latitude: start[0].Latitude, Longitude: start[0].Longitude, Weight: 1}}
+	if got := Recommend(start, aws, point, 100, 5); len(got) != 0 {
+		t.Errorf("Recommend() with full coverage = %v, want none", got)
+	}
+}
@@ -0,0 +1,179 @@
+package where
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LatLng is a geographic coordinate in degrees.
+type LatLng struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Polygon is a closed ring of coordinates; the last vertex connects back to
+// the first. Rings may cross the antimeridian: consecutive vertices are
+// joined the short way around, so {Lng: 170} to {Lng: -170} spans 20 degrees.
+type Polygon []LatLng
+
+// Contains reports whether the location lies inside the polygon.
+func (p Polygon) Contains(lat, lng float64) bool {
+	if len(p) < 3 {
+		return false
+	}
+	// Unwrap longitudes so the ring is continuous, then test the point and
+	// its copies a full turn east and west.
+	ring := make([]LatLng, len(p))
+	ring[0] = p[0]
+	for i := 1; i < len(p); i++ {
+		v := p[i]
+		for v.Lng-ring[i-1].Lng > 180 {
+			v.Lng -= 360
+		}
+		for v.Lng-ring[i-1].Lng < -180 {
+			v.Lng += 360
+		}
+		ring[i] = v
+	}
+	for _, shift := range []float64{0, 360, -360} {
+		if ringContains(ring, lat, lng+shift) {
+			return true
+		}
+	}
+	return false
+}
+
+// ringContains is the even-odd point-in-polygon test on planar coordinates.
+func ringContains(ring []LatLng, lat, lng float64) bool {
+	inside := false
+	j := len(ring) - 1
+	for i := range ring {
+		a, b := ring[i], ring[j]
+		if (a.Lat > lat) != (b.Lat > lat) && lng < (b.Lng-a.Lng)*(lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+		j = i
+	}
+	return inside
+}
+
+// WithinBBox filters regions inside a bounding box. When minLng is greater
+// than maxLng the box crosses the antimeridian, e.g. 170 to -170.
+func (s Set) WithinBBox(minLat, minLng, maxLat, maxLng float64) Set {
+	return s.Filter(func(r Region) bool {
+		if r.Latitude < minLat || r.Latitude > maxLat {
+			return false
+		}
+		if minLng <= maxLng {
+			return r.Longitude >= minLng && r.Longitude <= maxLng
+		}
+		return r.Longitude >= minLng || r.Longitude <= maxLng
+	})
+}
+
+// WithinPolygon filters regions inside a shape made of one or more rings,
+// using the even-odd rule: a ring inside another cuts a hole, and disjoint
+// rings add islands.
+func (s Set) WithinPolygon(rings ...Polygon) Set {
+	return s.Filter(func(r Region) bool {
+		return insideRings(rings, r.Latitude, r.Longitude)
+	})
+}
+
+// insideRings applies the even-odd rule across rings.
+func insideRings(rings []Polygon, lat, lng float64) bool {
+	inside := false
+	for _, ring := range rings {
+		if ring.Contains(lat, lng) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Area returns the rings of a built-in named area, for WithinPolygon:
+// "eu" (European Union member states), "nordics" (Denmark, Finland, Iceland,
+// Norway and Sweden) and "gcc" (the Gulf Cooperation Council states). The
+// outlines are coarse and meant for the locations of data centers, not for
+// legal boundaries. The rings are copies the caller may modify.
+func Area(name string) ([]Polygon, error) {
+	rings, ok := areas[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown area %q, want one of %s", name, strings.Join(Areas(), ", "))
+	}
+	result := make([]Polygon, len(rings))
+	for i, ring := range rings {
+		result[i] = append(Polygon(nil), ring...)
+	}
+	return result, nil
+}
+
+// Areas returns the sorted names of the built-in areas.
+func Areas() []string {
+	names := make([]string, 0, len(areas))
+	for name := range areas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WithinArea filters regions inside a built-in named area; see Area.
+func (s Set) WithinArea(name string) (Set, error) {
+	rings, err := Area(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.WithinPolygon(rings...), nil
+}
+
+// lngLatRing builds a polygon from longitude, latitude pairs.
+func lngLatRing(lngLat ...float64) Polygon {
+	p := make(Polygon, len(lngLat)/2)
+	for i := range p {
+		p[i] = LatLng{Lat: lngLat[2*i+1], Lng: lngLat[2*i]}
+	}
+	return p
+}
+
+// areas holds the built-in named areas, as rings of longitude, latitude pairs.
+var areas = map[string][]Polygon{
+	"eu": {
+		// Mainland, from Portugal around the Nordic and eastern borders.
+		lngLatRing(-9.5, 37, -9.5, 42, -9, 43.8, -1.8, 43.4, -1.5, 46, -4.8, 47.8, -4.8, 48.6, -1.5, 49.8,
+			1.5, 50.2, 2.5, 51.2, 3.5, 51.6, 4.8, 53.2, 7, 53.7, 8.5, 54.9, 8, 55.5, 8.1, 56.8, 10.6, 57.8,
+			11.2, 58.4, 11.4, 59.1, 11.8, 59.9, 12.5, 61, 12.1, 61.7, 12.3, 63, 14.2, 64.4, 14.5, 65.3,
+			16.5, 67.5, 18.2, 68.5, 20.3, 69.1, 21, 69.3, 23, 68.6, 25, 68.9, 26.5, 69.9, 28.5, 69.7, 29, 69,
+			28.5, 68, 30, 67.5, 29.5, 66, 30.5, 64, 31.5, 62.8, 29, 61.2, 27.7, 60.5, 28, 59.5, 27.5, 58,
+			27.7, 57.5, 28.2, 56.2, 26.6, 55.7, 26.8, 55.3, 25.7, 54.3, 23.5, 53.9, 23.9, 52.7, 23.6, 51.6,
+			24.1, 50.8, 22.6, 49.1, 22.2, 48.4, 23, 48, 24.9, 47.7, 26.6, 48.3, 27.3, 47.7, 28.2, 46.4,
+			28.2, 45.5, 29.7, 45.3, 28.6, 44.3, 28, 43, 27.9, 42, 26.5, 41.3, 26.1, 40.6, 23.5, 39.9, 24, 37.6,
+			22.5, 36.4, 21.1, 37.8, 20.2, 39.6, 20.6, 40.1, 21, 40.8, 22.9, 41.3, 23, 42, 22.4, 42.3, 22.9, 43.2,
+			22.4, 44, 21.4, 44.8, 20.3, 46.1, 19, 46.1, 19.4, 45.2, 19, 44.9, 16.5, 45.2, 15.8, 44.7, 17.5, 43.1,
+			18.5, 42.5, 17, 43.2, 15.2, 44.2, 13.6, 45.1, 13.6, 45.7, 12.3, 45.2, 12.4, 44.2, 13.6, 43.5,
+			14.4, 42.1, 16.2, 41.9, 18.5, 40.2, 17, 39, 15.7, 38, 15.7, 40, 14, 40.8, 12.3, 41.7, 11, 42.4,
+			10.5, 43.6, 9.3, 44.3, 8.2, 43.9, 7.5, 43.8, 6.2, 43.1, 4, 43.5, 3.1, 42.4, 3.2, 41.9, 0.9, 41,
+			-0.3, 39.5, 0.2, 38.7, -0.7, 37.6, -2.2, 36.7, -5.6, 36, -7.4, 37.2, -8.9, 37),
+		// Switzerland, cut out of the mainland.
+		lngLatRing(6, 46.1, 6.8, 47.5, 8.6, 47.8, 9.6, 47.5, 10.5, 46.9, 10.1, 46.2, 9, 45.8, 7.9, 45.9, 7, 45.9),
+		// Ireland, without Northern Ireland.
+		lngLatRing(-6.2, 52, -6, 53.9, -7.3, 54.1, -8.2, 54.6, -7.3, 55.4, -8.5, 55.2, -10.4, 54.2, -10.5, 51.6, -8, 51.5),
+		// Sicily and Cyprus.
+		lngLatRing(12.4, 38.1, 15.6, 38.3, 15.1, 36.7, 12.5, 37.6),
+		lngLatRing(32.3, 34.7, 34.6, 35.7, 34, 34.9, 33, 34.6),
+	},
+	"nordics": {
+		lngLatRing(8, 54.8, 8.1, 56.8, 4.6, 58, 4.8, 61, 5, 62.5, 10, 64.5, 13.5, 68, 16, 69.5, 20, 70.2, 25, 71.2,
+			31, 70.3, 29, 69, 28.5, 68, 30, 67.5, 29.5, 66, 30.5, 64, 31.5, 62.8, 29, 61.2, 27.7, 60.5, 23, 59.7,
+			19, 59.8, 19.1, 57.8, 16.5, 56, 15.3, 54.9, 12.5, 54.4, 11, 54.5, 9.6, 54.8),
+		// Iceland
+		lngLatRing(-24.5, 65.5, -22, 66.5, -16, 66.6, -13.5, 65.2, -14.5, 64.3, -18.7, 63.4, -22.7, 63.8),
+	},
+	"gcc": {
+		lngLatRing(34.9, 29.4, 37, 31.5, 39, 32.2, 42, 31.1, 44.7, 29.2, 46.5, 29.1, 47.7, 30.1, 48.5, 29.9, 50, 29,
+			52, 27, 56.2, 26.6, 56.4, 26.4, 57, 24, 59.8, 22.5, 58.5, 20.5, 57.7, 19, 55, 17.2, 52.2, 16.6,
+			51, 18.9, 49, 18.6, 46.5, 17.3, 44, 17.4, 43.2, 16.7, 42.8, 16.4, 41.5, 18, 39.5, 20, 38.5, 22.5,
+			37, 25, 35.2, 28),
+	},
+}
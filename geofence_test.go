@@ -0,0 +1,139 @@
+package where
+
+import "testing"
+
+func TestPolygonContains(t *testing.T) {
+	square := Polygon{{0, 0}, {0, 10}, {10, 10}, {10, 0}}
+	// A box around Fiji, across the antimeridian.
+	pacific := Polygon{{-10, 170}, {-10, -170}, {-25, -170}, {-25, 170}}
+	tests := []struct {
+		name string
+		p    Polygon
+		lat  float64
+		lng  float64
+		want bool
+	}{
+		{"inside", square, 5, 5, true},
+		{"outside", square, 5, 15, false},
+		{"degenerate", square[:2], 0, 0, false},
+		{"west of antimeridian", pacific, -18, 178, true},
+		{"east of antimeridian", pacific, -18, -178, true},
+		{"outside across antimeridian", pacific, -18, 160, false},
+		{"far side", pacific, -18, 0, false},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Contains(tt.lat, tt.lng); got != tt.want {
+			t.Errorf("Contains(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWithinBBox(t *testing.T) {
+	set := Set{
+		{Code: "a", Latitude: 50, Longitude: 8},
+		{Code: "b", Latitude: 35, Longitude: 139},
+		{Code: "c", Latitude: -18, Longitude: 178},
+		{Code: "d", Latitude: -14, Longitude: -171},
+	}
+	tests := []struct {
+		name                           string
+		minLat, minLng, maxLat, maxLng float64
+		want                           []Code
+	}{
+		{"europe", 35, -10, 70, 40, []Code{"a"}},
+		{"across antimeridian", -30, 170, 0, -160, []Code{"c", "d"}},
+		{"nothing", 80, 0, 90, 10, nil},
+	}
+	for _, tt := range tests {
+		got := set.WithinBBox(tt.minLat, tt.minLng, tt.maxLat, tt.maxLng).Codes()
+		if len(got) != len(tt.want) {
+			t.Errorf("WithinBBox(%s) = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("WithinBBox(%s) = %v, want %v", tt.name, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestWithinPolygonHoles(t *testing.T) {
+	outer := Polygon{{0, 0}, {0, 10}, {10, 10}, {10, 0}}
+	hole := Polygon{{4, 4}, {4, 6}, {6, 6}, {6, 4}}
+	island := Polygon{{20, 20}, {20, 22}, {22, 22}, {22, 20}}
+	set := Set{
+		{Code: "ring", Latitude: 2, Longitude: 2},
+		{Code: "hole", Latitude: 5, Longitude: 5},
+		{Code: "island", Latitude: 21, Longitude: 21},
+		{Code: "sea", Latitude: 15, Longitude: 15},
+	}
+	got := set.WithinPolygon(outer, hole, island).Codes()
+	if len(got) != 2 || got[0] != "ring" || got[1] != "island" {
+		t.Errorf("WithinPolygon() = %v, want [ring island]", got)
+	}
+}
+
+func TestWithinArea(t *testing.T) {
+	tests := []struct {
+		area    string
+		inside  []RegionID
+		outside []RegionID
+	}{
+		{"eu",
+			[]RegionID{"aws/eu-central-1", "aws/eu-west-1", "aws/eu-north-1", "aws/eu-south-2", "gcp/europe-central2", "azure/swedencentral"},
+			[]RegionID{"aws/eu-west-2", "aws/eu-central-2", "gcp/europe-west6", "azure/norwayeast", "aws/us-east-1"}},
+		{"nordics",
+			[]RegionID{"aws/eu-north-1", "gcp/europe-north1", "azure/norwayeast"},
+			[]RegionID{"aws/eu-central-1", "aws/eu-west-1"}},
+		{"GCC",
+			[]RegionID{"aws/me-south-1", "aws/me-central-1", "azure/qatarcentral", "azure/uaenorth"},
+			[]RegionID{"aws/il-central-1", "gcp/me-west1", "aws/ap-south-1"}},
+	}
+	for _, tt := range tests {
+		rings, err := Area(tt.area)
+		if err != nil {
+			t.Fatalf("Area(%s) error = %v", tt.area, err)
+		}
+		for _, id := range tt.inside {
+			r, err := ByID(id)
+			if err != nil {
+				t.Fatalf("ByID(%s) error = %v", id, err)
+			}
+			if !insideRings(rings, r.Latitude, r.Longitude) {
+				t.Errorf("%s is not in area %s", id, tt.area)
+			}
+		}
+		for _, id := range tt.outside {
+			r, err := ByID(id)
+			if err != nil {
+				t.Fatalf("ByID(%s) error = %v", id, err)
+			}
+			if insideRings(rings, r.Latitude, r.Longitude) {
+				t.Errorf("%s is in area %s", id, tt.area)
+			}
+		}
+	}
+
+	if _, err := Area("atlantis"); err == nil {
+		t.Error("Area(atlantis) succeeded, want error")
+	}
+
+	rings, _ := Area("eu")
+	for i := range rings {
+		for j := range rings[i] {
+			rings[i][j] = LatLng{}
+		}
+	}
+	frankfurt, _ := ByID("aws/eu-central-1")
+	if got, _ := (Set{frankfurt}).WithinArea("eu"); len(got) != 1 {
+		t.Error("modifying the rings returned by Area() changed the built-in area")
+	}
+	q := NewQuery().WithinArea("atlantis")
+	if _, errs := q.ExecWithErrors(); len(errs) != 1 {
+		t.Errorf("Query.WithinArea(atlantis) errors = %v, want 1", errs)
+	}
+	if got := NewQuery().OnProvider("aws").WithinArea("gcc").Codes(); len(got) != 2 {
+		t.Errorf("Query.WithinArea(gcc) on AWS = %v, want me-south-1 and me-central-1", got)
+	}
+}
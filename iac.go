@@ -0,0 +1,170 @@
+package where
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IaCRegion is the entry of a region in infrastructure-as-code lookup tables.
+// Equivalents maps each other provider in the exported set to the code of its
+// closest region there.
+type IaCRegion struct {
+	Name        string            `json:"name"`
+	Country     string            `json:"country"`
+	City        string            `json:"city"`
+	Zones       []string          `json:"zones"`
+	Latitude    float64           `json:"latitude"`
+	Longitude   float64           `json:"longitude"`
+	Equivalents map[string]string `json:"equivalents,omitempty"`
+}
+
+// IaCTable maps provider names to region codes to their entries. Keying by
+// provider first keeps codes shared by several providers, like us-east-1,
+// apart.
+type IaCTable map[string]map[Code]IaCRegion
+
+// IaCTable builds the lookup table of the set for infrastructure-as-code
+// tools; see WriteHCLLocals, WriteTFVarsJSON and WriteIaCJSON.
+func (s Set) IaCTable() IaCTable {
+	table := make(IaCTable)
+	for _, r := range s {
+		zones := make([]string, len(r.Zones))
+		copy(zones, r.Zones)
+		entry := IaCRegion{
+			Name:      r.Name,
+			Country:   r.Country,
+			City:      r.City,
+			Zones:     zones,
+			Latitude:  r.Latitude,
+			Longitude: r.Longitude,
+		}
+		for _, other := range s.providers() {
+			if other == r.Provider {
+				continue
+			}
+			if closest, ok := s.OnProvider(other).closestTo(r); ok {
+				if entry.Equivalents == nil {
+					entry.Equivalents = make(map[string]string)
+				}
+				entry.Equivalents[other] = string(closest.Code)
+			}
+		}
+		if table[r.Provider] == nil {
+			table[r.Provider] = make(map[Code]IaCRegion)
+		}
+		table[r.Provider][r.Code] = entry
+	}
+	return table
+}
+
+// providers returns the sorted provider names present in the set.
+func (s Set) providers() []string {
+	seen := make(map[string]bool)
+	var providers []string
+	for _, r := range s {
+		if !seen[r.Provider] {
+			seen[r.Provider] = true
+			providers = append(providers, r.Provider)
+		}
+	}
+	sort.Strings(providers)
+	return providers
+}
+
+// closestTo returns the region of the set closest to r.
+func (s Set) closestTo(r Region) (Region, bool) {
+	best, bestDist := Region{}, math.Inf(1)
+	for _, candidate := range s {
+		if d := r.Distance(candidate); d < bestDist {
+			best, bestDist = candidate, d
+		}
+	}
+	return best, len(s) > 0
+}
+
+// WriteIaCJSON writes the lookup table of the set as a JSON object, ready to
+// be imported by Jsonnet or read as Pulumi stack configuration.
+func (s Set) WriteIaCJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s.IaCTable())
+}
+
+// WriteTFVarsJSON writes the lookup table of the set as a Terraform JSON
+// variable file (*.tfvars.json) assigning it to the variable name.
+func (s Set) WriteTFVarsJSON(w io.Writer, name string) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]IaCTable{name: s.IaCTable()})
+}
+
+// WriteHCLLocals writes the lookup table of the set as a Terraform or
+// OpenTofu locals block defining the local value name:
+//
+//	locals {
+//	  regions = {
+//	    aws = {
+//	      "eu-west-1" = {
+//	        name = "Europe (Ireland)"
+//	        ...
+func (s Set) WriteHCLLocals(w io.Writer, name string) error {
+	bw := bufio.NewWriter(w)
+	table := s.IaCTable()
+	fmt.Fprintf(bw, "locals {\n  %s = {\n", name)
+	for _, provider := range s.providers() {
+		fmt.Fprintf(bw, "    %s = {\n", provider)
+		codes := make([]string, 0, len(table[provider]))
+		for code := range table[provider] {
+			codes = append(codes, string(code))
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			r := table[provider][Code(code)]
+			fmt.Fprintf(bw, "      %s = {\n", hclString(code))
+			fmt.Fprintf(bw, "        name      = %s\n", hclString(r.Name))
+			fmt.Fprintf(bw, "        country   = %s\n", hclString(r.Country))
+			fmt.Fprintf(bw, "        city      = %s\n", hclString(r.City))
+			fmt.Fprintf(bw, "        zones     = %s\n", hclList(r.Zones))
+			fmt.Fprintf(bw, "        latitude  = %s\n", strconv.FormatFloat(r.Latitude, 'f', -1, 64))
+			fmt.Fprintf(bw, "        longitude = %s\n", strconv.FormatFloat(r.Longitude, 'f', -1, 64))
+			if len(r.Equivalents) > 0 {
+				others := make([]string, 0, len(r.Equivalents))
+				for other := range r.Equivalents {
+					others = append(others, other)
+				}
+				sort.Strings(others)
+				fmt.Fprint(bw, "        equivalents = {\n")
+				for _, other := range others {
+					fmt.Fprintf(bw, "          %s = %s\n", other, hclString(r.Equivalents[other]))
+				}
+				fmt.Fprint(bw, "        }\n")
+			}
+			fmt.Fprint(bw, "      }\n")
+		}
+		fmt.Fprint(bw, "    }\n")
+	}
+	fmt.Fprint(bw, "  }\n}\n")
+	return bw.Flush()
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences.
+func hclString(s string) string {
+	q := strconv.Quote(s)
+	q = strings.ReplaceAll(q, "${", "$${")
+	return strings.ReplaceAll(q, "%{", "%%{")
+}
+
+// hclList formats a list of HCL string literals.
+func hclList(items []string) string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = hclString(item)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
@@ -0,0 +1,110 @@
+package where
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func iacTestSet(t *testing.T) Set {
+	t.Helper()
+	aws, _ := Is("eu-west-1").OnAWS()
+	gcp, _ := Is("europe-west1").OnGCP()
+	frankfurt, _ := Is("eu-central-1").OnAWS()
+	alibaba, _ := Is("eu-central-1").OnAlibaba()
+	return Set{aws, gcp, frankfurt, alibaba}
+}
+
+func TestIaCTable(t *testing.T) {
+	table := iacTestSet(t).IaCTable()
+	if len(table) != 3 || len(table["aws"]) != 2 {
+		t.Fatalf("IaCTable() = %v, want 3 providers and 2 AWS regions", table)
+	}
+	if got := table["alibaba"]["eu-central-1"].Name; !strings.Contains(got, "Frankfurt") {
+		t.Errorf("alibaba eu-central-1 name = %q", got)
+	}
+	ireland := table["aws"]["eu-west-1"]
+	if ireland.Equivalents["gcp"] != "europe-west1" || ireland.Equivalents["alibaba"] != "eu-central-1" {
+		t.Errorf("eu-west-1 equivalents = %v", ireland.Equivalents)
+	}
+	if _, ok := ireland.Equivalents["aws"]; ok {
+		t.Error("eu-west-1 lists its own provider as an equivalent")
+	}
+}
+
+func TestWriteHCLLocals(t *testing.T) {
+	var buf bytes.Buffer
+	if err := iacTestSet(t).WriteHCLLocals(&buf, "regions"); err != nil {
+		t.Fatalf("WriteHCLLocals() error = %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"locals {\n  regions = {\n    alibaba = {\n",
+		"      \"eu-west-1\" = {\n",
+		"        zones     = [\"eu-west-1a\", \"eu-west-1b\", \"eu-west-1c\"]\n",
+		"          gcp = \"europe-west1\"\n",
+		"  }\n}\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("WriteHCLLocals() output lacks %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "alibaba =") > strings.Index(out, "aws =") || strings.Index(out, "aws =") > strings.Index(out, "gcp =") {
+		t.Errorf("WriteHCLLocals() providers are not sorted:\n%s", out)
+	}
+	if strings.Count(out, "{") != strings.Count(out, "}") {
+		t.Errorf("WriteHCLLocals() braces are unbalanced:\n%s", out)
+	}
+}
+
+func TestHCLString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"São Paulo", `"São Paulo"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{"${var.x}", `"$${var.x}"`},
+		{"%{if}", `"%%{if}"`},
+	}
+	for _, tt := range tests {
+		if got := hclString(tt.in); got != tt.want {
+			t.Errorf("hclString(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestWriteTFVarsJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := iacTestSet(t).WriteTFVarsJSON(&buf, "cloud_regions"); err != nil {
+		t.Fatalf("WriteTFVarsJSON() error = %v", err)
+	}
+	var vars map[string]map[string]map[string]IaCRegion
+	if err := json.Unmarshal(buf.Bytes(), &vars); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := vars["cloud_regions"]["gcp"]["europe-west1"].Equivalents["aws"]; got != "eu-central-1" {
+		t.Errorf("cloud_regions.gcp.europe-west1.equivalents.aws = %q, want eu-central-1", got)
+	}
+
+	buf.Reset()
+	if err := NewQuery().OnProvider("gcp").Exec().WriteIaCJSON(&buf); err != nil {
+		t.Fatalf("WriteIaCJSON() error = %v", err)
+	}
+	var table IaCTable
+	if err := json.Unmarshal(buf.Bytes(), &table); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(table) != 1 || len(table["gcp"]) == 0 {
+		t.Errorf("WriteIaCJSON(gcp) providers = %d, regions = %d", len(table), len(table["gcp"]))
+	}
+
+	var edited IaCTable
+	if err := json.Unmarshal([]byte(`{"aws": {"us-east-99": {"name": "Future"}}}`), &edited); err != nil {
+		t.Fatalf("Unmarshal() of an uncatalogued region error = %v", err)
+	}
+	if got := edited["aws"]["us-east-99"].Name; got != "Future" {
+		t.Errorf("aws.us-east-99.name = %q, want Future", got)
+	}
+}
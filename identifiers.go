@@ -0,0 +1,259 @@
+package where
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ParseError is returned when a region cannot be extracted from a cloud
+// resource identifier. It wraps ErrRegionNotFound or ErrZoneNotFound when the
+// identifier was well-formed but named an unknown region or zone.
+type ParseError struct {
+	Kind   string // kind of identifier, e.g. "ARN" or "endpoint"
+	Input  string
+	Reason string
+	Err    error
+}
+
+// Error returns a message naming the identifier and what failed to parse.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse %s %q: %s", e.Kind, e.Input, e.Reason)
+}
+
+// Unwrap returns the underlying lookup error, if any.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// arnPartitions maps ARN partition names to catalog partitions.
+var arnPartitions = map[string]Partition{
+	"aws":        PartitionCommercial,
+	"aws-cn":     PartitionAWSChina,
+	"aws-us-gov": PartitionAWSGovCloud,
+	"aws-eusc":   PartitionAWSEuropeanSovereign,
+}
+
+// FromARN returns the AWS region an Amazon Resource Name refers to,
+// e.g. "arn:aws:sqs:us-west-2:123456789012:queue".
+func FromARN(arn string) (Region, error) {
+	fail := func(reason string, err error) (Region, error) {
+		return Region{}, &ParseError{Kind: "ARN", Input: arn, Reason: reason, Err: err}
+	}
+
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 || parts[0] != "arn" {
+		return fail(`expected "arn:partition:service:region:account:resource"`, nil)
+	}
+
+	partition, ok := arnPartitions[parts[1]]
+	if !ok {
+		return fail(fmt.Sprintf("unknown partition %q", parts[1]), nil)
+	}
+	if parts[3] == "" {
+		return fail(fmt.Sprintf("service %q is global and has no region", parts[2]), nil)
+	}
+
+	region, err := Is(Code(parts[3])).OnAWS()
+	if err != nil {
+		return fail(fmt.Sprintf("unknown region %q", parts[3]), ErrRegionNotFound)
+	}
+	if region.Partition != partition {
+		return fail(fmt.Sprintf("region %q is not in partition %q", parts[3], parts[1]), nil)
+	}
+	return region, nil
+}
+
+// FromAzureResourceID returns the Azure region of a resource. It accepts either
+// the ARM JSON of the resource, whose "location" field names the region, or a
+// resource ID that contains a "/locations/{name}" segment. Plain resource IDs
+// do not encode a location; pass the ARM JSON for those.
+func FromAzureResourceID(resource string) (Region, error) {
+	const kind = "Azure resource ID"
+	input := strings.TrimSpace(resource)
+
+	var location string
+	if strings.HasPrefix(input, "{") {
+		var arm struct {
+			ID       string `json:"id"`
+			Location string `json:"location"`
+		}
+		if err := json.Unmarshal([]byte(input), &arm); err != nil {
+			return Region{}, &ParseError{Kind: kind, Input: resource, Reason: "invalid ARM JSON: " + err.Error(), Err: err}
+		}
+		if arm.Location == "" {
+			return Region{}, &ParseError{Kind: kind, Input: resource, Reason: `ARM JSON has no "location" field`}
+		}
+		location = arm.Location
+	} else {
+		if !strings.HasPrefix(strings.ToLower(input), "/subscriptions/") {
+			return Region{}, &ParseError{Kind: kind, Input: resource, Reason: `expected ARM JSON or an ID starting with "/subscriptions/"`}
+		}
+		location = pathValue(input, "locations")
+		if location == "" {
+			return Region{}, &ParseError{Kind: kind, Input: resource, Reason: "resource ID has no location segment; pass the ARM JSON instead"}
+		}
+	}
+
+	code := Code(strings.ToLower(strings.ReplaceAll(location, " ", "")))
+	if code == "global" {
+		return Region{}, &ParseError{Kind: kind, Input: resource, Reason: "resource is global and has no region"}
+	}
+	region, err := Is(code).OnAzure()
+	if err != nil {
+		return Region{}, &ParseError{Kind: kind, Input: resource, Reason: fmt.Sprintf("unknown location %q", location), Err: ErrRegionNotFound}
+	}
+	return region, nil
+}
+
+// FromGCPSelfLink returns the Google Cloud region of a resource self link or
+// API path, e.g. ".../projects/p/regions/europe-west1/subnetworks/default" or
+// ".../zones/us-central1-a/instances/vm".
+func FromGCPSelfLink(link string) (Region, error) {
+	const kind = "GCP self link"
+	if code := pathValue(link, "regions"); code != "" {
+		region, err := Is(Code(code)).OnGCP()
+		if err != nil {
+			return Region{}, &ParseError{Kind: kind, Input: link, Reason: fmt.Sprintf("unknown region %q", code), Err: ErrRegionNotFound}
+		}
+		return region, nil
+	}
+
+	name := pathValue(link, "zones")
+	if name == "" {
+		name = pathValue(link, "locations")
+	}
+	if name == "" {
+		return Region{}, &ParseError{Kind: kind, Input: link, Reason: `no "regions", "zones" or "locations" segment`}
+	}
+	if region, ok := lookup(ProviderGCP, Code(name)); ok {
+		return region, nil
+	}
+	if i := strings.LastIndex(name, "-"); i > 0 {
+		if region, ok := lookup(ProviderGCP, Code(name[:i])); ok {
+			return region, nil
+		}
+	}
+	return Region{}, &ParseError{Kind: kind, Input: link, Reason: fmt.Sprintf("unknown location %q", name), Err: ErrRegionNotFound}
+}
+
+// FromZone returns the region a zone belongs to, e.g. "us-east-1a",
+// "europe-west1-b" or "us-west-2-lax-1a". Zone names shared by several
+// providers resolve as FindZone does.
+func FromZone(name string) (Region, error) {
+	zone, err := FindZone(name)
+	if err != nil {
+		return Region{}, &ParseError{Kind: "zone", Input: name, Reason: "unknown zone", Err: ErrZoneNotFound}
+	}
+	region, err := zone.ParentRegion()
+	if err != nil {
+		return Region{}, &ParseError{Kind: "zone", Input: name, Reason: fmt.Sprintf("unknown parent region %q", zone.Region), Err: ErrRegionNotFound}
+	}
+	return region, nil
+}
+
+// endpointDomains maps service domain suffixes to the provider that owns them.
+// Longer suffixes are listed first so that the most specific one wins.
+var endpointDomains = []struct {
+	Suffix   string
+	Provider string
+}{
+	{"api.amazonwebservices.com.cn", ProviderAWS},
+	{"api.amazonwebservices.eu", ProviderAWS},
+	{"amazonaws.com.cn", ProviderAWS},
+	{"amazonaws.com", ProviderAWS},
+	{"amazonaws.eu", ProviderAWS},
+	{"api.aws", ProviderAWS},
+	{"cognitive.microsoft.com", ProviderAzure},
+	{"cognitive.microsoft.us", ProviderAzure},
+	{"chinacloudapi.cn", ProviderAzure},
+	{"usgovcloudapi.net", ProviderAzure},
+	{"azure.com", ProviderAzure},
+	{"azure.net", ProviderAzure},
+	{"windows.net", ProviderAzure},
+	{"azure.cn", ProviderAzure},
+	{"azure.us", ProviderAzure},
+	{"googleapis.com", ProviderGCP},
+	{"pkg.dev", ProviderGCP},
+	{"aliyuncs.com", ProviderAlibaba},
+	{"tencentcloudapi.com", ProviderTencent},
+	{"myqcloud.com", ProviderTencent},
+	{"myhuaweicloud.com", ProviderHuawei},
+	{"myhuaweicloud.eu", ProviderHuawei},
+	{"digitaloceanspaces.com", ProviderDigitalOcean},
+	{"linodeobjects.com", ProviderLinode},
+	{"vultrobjects.com", ProviderVultr},
+}
+
+// FromEndpoint returns the region a service endpoint hostname or URL points
+// at, e.g. "ec2.eu-west-1.amazonaws.com", "europe-west1-run.googleapis.com" or
+// "https://compute.googleapis.com/compute/v1/projects/p/regions/us-east1".
+func FromEndpoint(endpoint string) (Region, error) {
+	const kind = "endpoint"
+	host, path := endpoint, ""
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return Region{}, &ParseError{Kind: kind, Input: endpoint, Reason: "invalid URL: " + err.Error(), Err: err}
+		}
+		host, path = u.Hostname(), u.Path
+	} else if i := strings.IndexByte(endpoint, '/'); i >= 0 {
+		host, path = endpoint[:i], endpoint[i:]
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if i := strings.LastIndexByte(host, ':'); i >= 0 {
+		host = host[:i]
+	}
+
+	provider := ""
+	for _, domain := range endpointDomains {
+		if host == domain.Suffix || strings.HasSuffix(host, "."+domain.Suffix) {
+			provider = domain.Provider
+			host = strings.TrimSuffix(strings.TrimSuffix(host, domain.Suffix), ".")
+			break
+		}
+	}
+	if provider == "" {
+		return Region{}, &ParseError{Kind: kind, Input: endpoint, Reason: "host is not a known cloud service domain"}
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if region, ok := regionInLabel(label, provider); ok {
+			return region, nil
+		}
+	}
+	if provider == ProviderGCP && path != "" {
+		if region, err := FromGCPSelfLink(path); err == nil {
+			return region, nil
+		}
+	}
+	return Region{}, &ParseError{Kind: kind, Input: endpoint, Reason: fmt.Sprintf("no %s region in host", provider), Err: ErrRegionNotFound}
+}
+
+// regionInLabel finds the longest run of dash-separated words in a hostname
+// label that is a region code of the provider, so that "s3-website-us-east-1"
+// and "europe-west1-run" both resolve.
+func regionInLabel(label, provider string) (Region, bool) {
+	words := strings.Split(label, "-")
+	for n := len(words); n > 0; n-- {
+		for i := 0; i+n <= len(words); i++ {
+			if region, ok := lookup(provider, Code(strings.Join(words[i:i+n], "-"))); ok {
+				return region, true
+			}
+		}
+	}
+	return Region{}, false
+}
+
+// pathValue returns the path segment following key, e.g. pathValue(p, "zones")
+// on ".../zones/us-central1-a/instances/vm" returns "us-central1-a".
+func pathValue(path, key string) string {
+	segments := strings.Split(path, "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], key) {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,190 @@
+package where
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFromARN(t *testing.T) {
+	tests := []struct {
+		arn      string
+		expected Code
+		wantErr  error
+	}{
+		{"arn:aws:s3:us-west-2:123456789012:accesspoint/test", "us-west-2", nil},
+		{"arn:aws:sqs:eu-west-1:123456789012:queue", "eu-west-1", nil},
+		{"arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-0abc", "cn-north-1", nil},
+		{"arn:aws-us-gov:lambda:us-gov-west-1:123456789012:function:fn", "us-gov-west-1", nil},
+		{"arn:aws:iam::123456789012:role/admin", "", nil},
+		{"arn:aws:ec2:mars-north-1:123456789012:instance/i-0abc", "", ErrRegionNotFound},
+		{"arn:aws:ec2:cn-north-1:123456789012:instance/i-0abc", "", nil},
+		{"not-an-arn", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arn, func(t *testing.T) {
+			region, err := FromARN(tt.arn)
+			if tt.expected == "" {
+				var perr *ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("FromARN() error = %v, want *ParseError", err)
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("FromARN() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromARN() failed: %v", err)
+			}
+			if region.Code != tt.expected || region.Provider != ProviderAWS {
+				t.Errorf("FromARN() = %v, want aws/%v", region.ID(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromAzureResourceID(t *testing.T) {
+	arm := `{"id": "/subscriptions/0000/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm", "location": "westus2"}`
+	region, err := FromAzureResourceID(arm)
+	if err != nil {
+		t.Fatalf("FromAzureResourceID() failed: %v", err)
+	}
+	if region.ID() != "azure/westus2" {
+		t.Errorf("FromAzureResourceID() = %v, want azure/westus2", region.ID())
+	}
+
+	region, err = FromAzureResourceID(`{"location": "Sweden Central"}`)
+	if err != nil || region.Code != "swedencentral" {
+		t.Errorf("FromAzureResourceID() should normalize display names, got %v, %v", region.Code, err)
+	}
+
+	region, err = FromAzureResourceID("/subscriptions/0000/providers/Microsoft.Web/locations/uksouth/managedApis/office365")
+	if err != nil || region.Code != "uksouth" {
+		t.Errorf("FromAzureResourceID() should read location segments, got %v, %v", region.Code, err)
+	}
+
+	for _, input := range []string{
+		"/subscriptions/0000/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa",
+		`{"location": "global"}`,
+		`{"location": `,
+		"westus2",
+	} {
+		var perr *ParseError
+		if _, err := FromAzureResourceID(input); !errors.As(err, &perr) {
+			t.Errorf("FromAzureResourceID(%q) error = %v, want *ParseError", input, err)
+		}
+	}
+}
+
+func TestFromGCPSelfLink(t *testing.T) {
+	tests := []struct {
+		link     string
+		expected Code
+	}{
+		{"https://www.googleapis.com/compute/v1/projects/p/regions/europe-west1/subnetworks/default", "europe-west1"},
+		{"projects/p/zones/us-central1-a/instances/vm", "us-central1"},
+		{"projects/p/locations/asia-northeast1/functions/fn", "asia-northeast1"},
+	}
+
+	for _, tt := range tests {
+		region, err := FromGCPSelfLink(tt.link)
+		if err != nil {
+			t.Errorf("FromGCPSelfLink(%q) failed: %v", tt.link, err)
+			continue
+		}
+		if region.Code != tt.expected || region.Provider != ProviderGCP {
+			t.Errorf("FromGCPSelfLink(%q) = %v, want gcp/%v", tt.link, region.ID(), tt.expected)
+		}
+	}
+
+	if _, err := FromGCPSelfLink("projects/p/global/networks/default"); err == nil {
+		t.Error("FromGCPSelfLink() should fail for global resources")
+	}
+	if _, err := FromGCPSelfLink("projects/p/regions/moon-central1"); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("FromGCPSelfLink() should wrap ErrRegionNotFound, got %v", err)
+	}
+}
+
+func TestFromZone(t *testing.T) {
+	tests := []struct {
+		zone     string
+		expected RegionID
+	}{
+		{"us-east-1a", "aws/us-east-1"},
+		{"europe-west1-b", "gcp/europe-west1"},
+		{"us-west-2-lax-1a", "aws/us-west-2"},
+	}
+
+	for _, tt := range tests {
+		region, err := FromZone(tt.zone)
+		if err != nil {
+			t.Errorf("FromZone(%q) failed: %v", tt.zone, err)
+			continue
+		}
+		if region.ID() != tt.expected {
+			t.Errorf("FromZone(%q) = %v, want %v", tt.zone, region.ID(), tt.expected)
+		}
+	}
+
+	if _, err := FromZone("us-east-1z"); !errors.Is(err, ErrZoneNotFound) {
+		t.Errorf("FromZone() should wrap ErrZoneNotFound, got %v", err)
+	}
+}
+
+func TestFromEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		expected RegionID
+	}{
+		{"ec2.eu-west-1.amazonaws.com", "aws/eu-west-1"},
+		{"https://s3.dualstack.us-east-2.amazonaws.com/bucket/key", "aws/us-east-2"},
+		{"s3-website-us-west-2.amazonaws.com", "aws/us-west-2"},
+		{"ec2.cn-north-1.amazonaws.com.cn:443", "aws/cn-north-1"},
+		{"europe-west1-run.googleapis.com", "gcp/europe-west1"},
+		{"us-docker.pkg.dev", ""},
+		{"https://compute.googleapis.com/compute/v1/projects/p/regions/us-east1/addresses", "gcp/us-east1"},
+		{"oss-cn-hangzhou.aliyuncs.com", "alibaba/cn-hangzhou"},
+		{"fra1.digitaloceanspaces.com", "digitalocean/fra1"},
+		{"s3.amazonaws.com", ""},
+		{"example.com", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			region, err := FromEndpoint(tt.endpoint)
+			if tt.expected == "" {
+				var perr *ParseError
+				if !errors.As(err, &perr) {
+					t.Errorf("FromEndpoint() error = %v, want *ParseError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromEndpoint() failed: %v", err)
+			}
+			if region.ID() != tt.expected {
+				t.Errorf("FromEndpoint() = %v, want %v", region.ID(), tt.expected)
+			}
+		})
+	}
+}
+
+func BenchmarkFromEndpoint(b *testing.B) {
+	hosts := []string{
+		"s3-website-accelerate-dualstack-fips-us-east-1.amazonaws.com",
+		"https://europe-west1-run.googleapis.com/v2/projects/p/locations/europe-west1/services/s",
+		"myaccount.blob.core.windows.net",
+	}
+	for i := 0; i < b.N; i++ {
+		for _, host := range hosts {
+			_, _ = FromEndpoint(host)
+		}
+	}
+}
+
+func BenchmarkFromGCPSelfLink(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = FromGCPSelfLink("https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/instances/vm")
+	}
+}
@@ -0,0 +1,311 @@
+package where
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// InstanceOfferings records which compute instance types (AWS instance types,
+// Azure VM SKUs) are offered in which regions and zones. It is built from the
+// providers' offering exports and is immutable once built.
+type InstanceOfferings struct {
+	regions map[RegionID]map[string]string // lowercased type -> type as exported
+	zones   map[string]map[string]string   // keyed by zoneKey
+}
+
+// newInstanceOfferings returns an empty InstanceOfferings.
+func newInstanceOfferings() *InstanceOfferings {
+	return &InstanceOfferings{
+		regions: make(map[RegionID]map[string]string),
+		zones:   make(map[string]map[string]string),
+	}
+}
+
+// zoneKey identifies a zone across providers.
+func zoneKey(provider, name string) string {
+	return provider + "/" + name
+}
+
+// addRegion records an instance type as offered in a region.
+func (o *InstanceOfferings) addRegion(id RegionID, instanceType string) {
+	if o.regions[id] == nil {
+		o.regions[id] = make(map[string]string)
+	}
+	o.regions[id][strings.ToLower(instanceType)] = instanceType
+}
+
+// addZone records an instance type as offered in a zone and, for an
+// availability zone, in its region. Local and Wavelength Zones stay out of
+// the region: a type offered only there is not offered in the region itself.
+func (o *InstanceOfferings) addZone(z Zone, instanceType string) {
+	key := zoneKey(z.Provider, z.Name)
+	if o.zones[key] == nil {
+		o.zones[key] = make(map[string]string)
+	}
+	o.zones[key][strings.ToLower(instanceType)] = instanceType
+	if z.Kind == AvailabilityZone {
+		o.addRegion(NewRegionID(z.Provider, z.Region), instanceType)
+	}
+}
+
+// ReadAWSInstanceOfferings reads the JSON output of
+// "aws ec2 describe-instance-type-offerings" with --location-type "region" or
+// "availability-zone". Offerings keyed by zone ID ("use1-az1") cannot be
+// mapped to zone names and are rejected. As with ReadAzureSKUs, regions and
+// zones the catalog does not track are skipped.
+func ReadAWSInstanceOfferings(r io.Reader) (*InstanceOfferings, error) {
+	var export struct {
+		InstanceTypeOfferings []struct {
+			InstanceType string
+			LocationType string
+			Location     string
+		}
+	}
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, fmt.Errorf("read AWS instance offerings: %w", err)
+	}
+
+	o := newInstanceOfferings()
+	for _, offering := range export.InstanceTypeOfferings {
+		switch offering.LocationType {
+		case "region":
+			region, ok := lookup(ProviderAWS, Code(offering.Location))
+			if !ok {
+				// Exports include regions the catalog does not track.
+				continue
+			}
+			o.addRegion(region.ID(), offering.InstanceType)
+		case "availability-zone":
+			zone, ok := awsZone(offering.Location)
+			if !ok {
+				continue
+			}
+			o.addZone(zone, offering.InstanceType)
+		default:
+			return nil, fmt.Errorf("read AWS instance offerings: unsupported location type %q", offering.LocationType)
+		}
+	}
+	return o, nil
+}
+
+// awsZone returns the AWS zone of a zone name. Availability zones missing
+// from the catalog resolve by dropping the zone letter.
+func awsZone(name string) (Zone, bool) {
+	if zone, ok := findZone(ProviderAWS, name); ok {
+		return zone, true
+	}
+	if len(name) > 1 {
+		if region, ok := lookup(ProviderAWS, Code(name[:len(name)-1])); ok {
+			return Zone{Name: name, Kind: AvailabilityZone, Provider: ProviderAWS, Region: region.Code}, true
+		}
+	}
+	return Zone{}, false
+}
+
+// ReadAzureSKUs reads the JSON output of "az vm list-skus". Only virtual
+// machine SKUs are kept, and locations and zones listed in the SKU's
+// restrictions are treated as not offered.
+func ReadAzureSKUs(r io.Reader) (*InstanceOfferings, error) {
+	var skus []struct {
+		ResourceType string   `json:"resourceType"`
+		Name         string   `json:"name"`
+		Locations    []string `json:"locations"`
+		LocationInfo []struct {
+			Location string   `json:"location"`
+			Zones    []string `json:"zones"`
+		} `json:"locationInfo"`
+		Restrictions []struct {
+			Type            string   `json:"type"`
+			Values          []string `json:"values"`
+			RestrictionInfo struct {
+				Locations []string `json:"locations"`
+				Zones     []string `json:"zones"`
+			} `json:"restrictionInfo"`
+		} `json:"restrictions"`
+	}
+	if err := json.NewDecoder(r).Decode(&skus); err != nil {
+		return nil, fmt.Errorf("read Azure SKUs: %w", err)
+	}
+
+	o := newInstanceOfferings()
+	for _, sku := range skus {
+		if !strings.EqualFold(sku.ResourceType, "virtualMachines") {
+			continue
+		}
+		restricted := make(map[string]bool)
+		for _, restriction := range sku.Restrictions {
+			switch restriction.Type {
+			case "Location":
+				for _, location := range restriction.Values {
+					restricted[strings.ToLower(location)] = true
+				}
+			case "Zone":
+				for _, location := range restriction.RestrictionInfo.Locations {
+					for _, zone := range restriction.RestrictionInfo.Zones {
+						restricted[strings.ToLower(location)+"-"+zone] = true
+					}
+				}
+			}
+		}
+
+		for _, location := range sku.Locations {
+			code := Code(strings.ToLower(location))
+			if restricted[string(code)] {
+				continue
+			}
+			if _, ok := lookup(ProviderAzure, code); !ok {
+				// Exports include locations the catalog does not track.
+				continue
+			}
+			o.addRegion(NewRegionID(ProviderAzure, code), sku.Name)
+		}
+		for _, info := range sku.LocationInfo {
+			code := strings.ToLower(info.Location)
+			if restricted[code] {
+				continue
+			}
+			if _, ok := lookup(ProviderAzure, Code(code)); !ok {
+				continue
+			}
+			for _, zone := range info.Zones {
+				if name := code + "-" + zone; !restricted[name] {
+					o.addZone(Zone{Name: name, Kind: AvailabilityZone, Provider: ProviderAzure, Region: Code(code)}, sku.Name)
+				}
+			}
+		}
+	}
+	return o, nil
+}
+
+// LoadInstanceOfferings reads and merges offering exports from files. Each
+// file may be an AWS describe-instance-type-offerings export or an Azure
+// list-skus export; the format is detected from the content.
+func LoadInstanceOfferings(paths ...string) (*InstanceOfferings, error) {
+	merged := newInstanceOfferings()
+	for _, path := range paths {
+		o, err := loadInstanceOfferings(path)
+		if err != nil {
+			return nil, err
+		}
+		merged = merged.Merge(o)
+	}
+	return merged, nil
+}
+
+// loadInstanceOfferings reads a single export file.
+func loadInstanceOfferings(path string) (*InstanceOfferings, error) {
+	return loadFile(path, readInstanceOfferings)
+}
+
+// readInstanceOfferings reads an AWS or Azure export, telling them apart by
+// the first character: Azure exports are JSON arrays.
+func readInstanceOfferings(f io.Reader) (*InstanceOfferings, error) {
+	r := bufio.NewReader(f)
+	for {
+		b, err := r.Peek(1)
+		if err != nil {
+			return nil, fmt.Errorf("read instance offerings: %w", err)
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = r.ReadByte()
+		case '[':
+			return ReadAzureSKUs(r)
+		default:
+			return ReadAWSInstanceOfferings(r)
+		}
+	}
+}
+
+// Merge returns offerings combining o and other.
+func (o *InstanceOfferings) Merge(other *InstanceOfferings) *InstanceOfferings {
+	merged := newInstanceOfferings()
+	for _, src := range []*InstanceOfferings{o, other} {
+		for id, types := range src.regions {
+			for _, t := range types {
+				merged.addRegion(id, t)
+			}
+		}
+		for key, types := range src.zones {
+			if merged.zones[key] == nil {
+				merged.zones[key] = make(map[string]string)
+			}
+			for lower, t := range types {
+				merged.zones[key][lower] = t
+			}
+		}
+	}
+	return merged
+}
+
+// Offers reports whether the instance type is offered in the region.
+// Instance type names are case-insensitive.
+func (o *InstanceOfferings) Offers(id RegionID, instanceType string) bool {
+	_, ok := o.regions[id][strings.ToLower(instanceType)]
+	return ok
+}
+
+// ZoneOffers reports whether the instance type is offered in the zone.
+func (o *InstanceOfferings) ZoneOffers(z Zone, instanceType string) bool {
+	_, ok := o.zones[zoneKey(z.Provider, z.Name)][strings.ToLower(instanceType)]
+	return ok
+}
+
+// InstanceTypes returns the sorted instance types offered in the region.
+func (o *InstanceOfferings) InstanceTypes(id RegionID) []string {
+	types := make([]string, 0, len(o.regions[id]))
+	for _, t := range o.regions[id] {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// offeringData is the data consulted by Offers and OffersInstance; it is empty
+// until offerings are installed.
+var offeringData = dataset[InstanceOfferings]{baseline: newInstanceOfferings()}
+
+// UseInstanceOfferings installs the offerings consulted by Region.Offers,
+// Zone.Offers and the OffersInstance filters. The package ships no offering
+// data, so nothing is offered until offerings are installed; pass nil to
+// remove them again.
+func UseInstanceOfferings(o *InstanceOfferings) {
+	offeringData.use(o)
+}
+
+// CurrentInstanceOfferings returns the installed offerings, empty if none.
+func CurrentInstanceOfferings() *InstanceOfferings {
+	return offeringData.current()
+}
+
+// Offers reports whether the instance type is offered in the region
+// according to the installed offerings.
+func (r Region) Offers(instanceType string) bool {
+	return CurrentInstanceOfferings().Offers(r.ID(), instanceType)
+}
+
+// Offers reports whether the instance type is offered in the zone
+// according to the installed offerings.
+func (z Zone) Offers(instanceType string) bool {
+	return CurrentInstanceOfferings().ZoneOffers(z, instanceType)
+}
+
+// OffersInstance filters regions offering the instance type.
+func (s Set) OffersInstance(instanceType string) Set {
+	offerings := CurrentInstanceOfferings()
+	return s.Filter(func(r Region) bool {
+		return offerings.Offers(r.ID(), instanceType)
+	})
+}
+
+// OffersInstance filters zones offering the instance type.
+func (s ZoneSet) OffersInstance(instanceType string) ZoneSet {
+	offerings := CurrentInstanceOfferings()
+	return s.Filter(func(z Zone) bool {
+		return offerings.ZoneOffers(z, instanceType)
+	})
+}
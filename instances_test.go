@@ -0,0 +1,164 @@
+package where
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const awsOfferingsJSON = `{
+  "InstanceTypeOfferings": [
+    {"InstanceType": "p5.48xlarge", "LocationType": "availability-zone", "Location": "us-east-1a"},
+    {"InstanceType": "p5.48xlarge", "LocationType": "availability-zone", "Location": "us-east-1f"},
+    {"InstanceType": "m7i.large", "LocationType": "availability-zone", "Location": "us-west-2-lax-1a"},
+    {"InstanceType": "m7i.large", "LocationType": "region", "Location": "eu-west-1"},
+    {"InstanceType": "m7i.large", "LocationType": "region", "Location": "us-east-99"},
+    {"InstanceType": "m7i.large", "LocationType": "availability-zone", "Location": "us-east-99a"}
+  ]
+}`
+
+const azureSKUsJSON = `[
+  {
+    "resourceType": "virtualMachines",
+    "name": "Standard_ND96isr_H100_v5",
+    "locations": ["eastus"],
+    "locationInfo": [{"location": "eastus", "zones": ["1", "2", "3"]}],
+    "restrictions": [
+      {"type": "Zone", "values": ["eastus"], "restrictionInfo": {"locations": ["eastus"], "zones": ["3"]}}
+    ]
+  },
+  {
+    "resourceType": "virtualMachines",
+    "name": "Standard_D2s_v5",
+    "locations": ["swedencentral", "westus"],
+    "locationInfo": [],
+    "restrictions": [
+      {"type": "Location", "values": ["westus"], "reasonCode": "NotAvailableForSubscription"}
+    ]
+  },
+  {"resourceType": "disks", "name": "Premium_LRS", "locations": ["eastus"]}
+]`
+
+func TestReadAWSInstanceOfferings(t *testing.T) {
+	o, err := ReadAWSInstanceOfferings(strings.NewReader(awsOfferingsJSON))
+	if err != nil {
+		t.Fatalf("ReadAWSInstanceOfferings() failed: %v", err)
+	}
+
+	tests := []struct {
+		id       RegionID
+		instance string
+		expected bool
+	}{
+		{"aws/us-east-1", "p5.48xlarge", true},
+		{"aws/us-east-1", "P5.48XLARGE", true},
+		{"aws/us-west-2", "m7i.large", false},
+		{"aws/eu-west-1", "m7i.large", true},
+		{"aws/eu-west-1", "p5.48xlarge", false},
+		{"aws/us-east-99", "m7i.large", false},
+	}
+	for _, tt := range tests {
+		if got := o.Offers(tt.id, tt.instance); got != tt.expected {
+			t.Errorf("Offers(%v, %v) = %v, want %v", tt.id, tt.instance, got, tt.expected)
+		}
+	}
+
+	zones := MustIs("us-east-1").AvailabilityZones()
+	if !o.ZoneOffers(zones[0], "p5.48xlarge") || o.ZoneOffers(zones[1], "p5.48xlarge") {
+		t.Error("ZoneOffers() should report p5.48xlarge in us-east-1a only")
+	}
+
+	lax, _ := findZone(ProviderAWS, "us-west-2-lax-1a")
+	if !o.ZoneOffers(lax, "m7i.large") {
+		t.Error("ZoneOffers() should report m7i.large in the us-west-2-lax-1 Local Zone")
+	}
+	if got := len(o.regions); got != 2 {
+		t.Errorf("ReadAWSInstanceOfferings() kept %d regions, want 2 with unknown locations skipped", got)
+	}
+
+	bad := `{"InstanceTypeOfferings": [{"InstanceType": "t3.micro", "LocationType": "availability-zone-id", "Location": "use1-az1"}]}`
+	if _, err := ReadAWSInstanceOfferings(strings.NewReader(bad)); err == nil {
+		t.Error("ReadAWSInstanceOfferings() should reject zone IDs")
+	}
+}
+
+func TestReadAzureSKUs(t *testing.T) {
+	o, err := ReadAzureSKUs(strings.NewReader(azureSKUsJSON))
+	if err != nil {
+		t.Fatalf("ReadAzureSKUs() failed: %v", err)
+	}
+
+	if !o.Offers("azure/eastus", "standard_nd96isr_h100_v5") {
+		t.Error("eastus should offer the H100 SKU")
+	}
+	if !o.Offers("azure/swedencentral", "Standard_D2s_v5") {
+		t.Error("swedencentral should offer Standard_D2s_v5")
+	}
+	if o.Offers("azure/westus", "Standard_D2s_v5") {
+		t.Error("location restrictions should remove westus")
+	}
+	if o.Offers("azure/eastus", "Premium_LRS") {
+		t.Error("non-VM resource types should be ignored")
+	}
+
+	zones := ZoneSet{}
+	for _, z := range MustIs("eastus").AvailabilityZones() {
+		if o.ZoneOffers(z, "Standard_ND96isr_H100_v5") {
+			zones = append(zones, z)
+		}
+	}
+	if got := strings.Join(zones.Names(), ","); got != "eastus-1,eastus-2" {
+		t.Errorf("zones offering H100 = %v, want eastus-1,eastus-2", got)
+	}
+	if got := o.InstanceTypes("azure/eastus"); len(got) != 1 || got[0] != "Standard_ND96isr_H100_v5" {
+		t.Errorf("InstanceTypes() = %v, want [Standard_ND96isr_H100_v5]", got)
+	}
+}
+
+func TestUseInstanceOfferings(t *testing.T) {
+	defer UseInstanceOfferings(nil)
+
+	dir := t.TempDir()
+	awsPath := filepath.Join(dir, "aws.json")
+	azurePath := filepath.Join(dir, "azure.json")
+	if err := os.WriteFile(awsPath, []byte(awsOfferingsJSON), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(azurePath, []byte("\n"+azureSKUsJSON), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	o, err := LoadInstanceOfferings(awsPath, azurePath)
+	if err != nil {
+		t.Fatalf("LoadInstanceOfferings() failed: %v", err)
+	}
+
+	if MustIs("us-east-1").Offers("p5.48xlarge") {
+		t.Error("nothing should be offered before offerings are installed")
+	}
+	UseInstanceOfferings(o)
+
+	got := NewQuery().OffersInstance("p5.48xlarge").Codes()
+	if len(got) != 1 || got[0] != "us-east-1" {
+		t.Errorf("OffersInstance() = %v, want [us-east-1]", got)
+	}
+	if !MustIs("swedencentral").Offers("Standard_D2s_v5") {
+		t.Error("merged offerings should include Azure SKUs")
+	}
+
+	lax, err := FindZone("us-west-2-lax-1a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !lax.Offers("m7i.large") {
+		t.Error("Zone.Offers() should report local zone offerings")
+	}
+	if got := strings.Join(MustIs("us-east-1").AvailabilityZones().OffersInstance("p5.48xlarge").Names(), ","); got != "us-east-1a,us-east-1f" {
+		t.Errorf("ZoneSet.OffersInstance() = %v, want us-east-1a,us-east-1f", got)
+	}
+
+	if _, err := LoadInstanceOfferings(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("LoadInstanceOfferings() should fail for missing files")
+	}
+}
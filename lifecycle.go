@@ -0,0 +1,122 @@
+package where
+
+import (
+	"sort"
+	"time"
+)
+
+// Lifecycle holds the milestones of a region besides its launch (GA), which
+// is Region.LaunchDate. Zero times are unknown or not applicable.
+type Lifecycle struct {
+	Announced         time.Time `json:"announced" yaml:"announced"`
+	Preview           time.Time `json:"preview" yaml:"preview"`                       // start of limited availability
+	DeprecationNotice time.Time `json:"deprecation_notice" yaml:"deprecation_notice"` // retirement announced; avoid for new work
+	Retirement        time.Time `json:"retirement" yaml:"retirement"`                 // region shut down
+}
+
+// EventKind names a lifecycle milestone.
+type EventKind string
+
+const (
+	// EventAnnounced is the public announcement of a region.
+	EventAnnounced EventKind = "announced"
+	// EventPreview is the start of preview or limited availability.
+	EventPreview EventKind = "preview"
+	// EventGA is general availability, the region's LaunchDate.
+	EventGA EventKind = "ga"
+	// EventDeprecationNotice is the announcement that a region will retire.
+	EventDeprecationNotice EventKind = "deprecation_notice"
+	// EventRetirement is the shutdown of a region.
+	EventRetirement EventKind = "retirement"
+)
+
+// LifecycleEvent is a dated lifecycle milestone of a region.
+type LifecycleEvent struct {
+	Kind EventKind `json:"kind"`
+	Date time.Time `json:"date"`
+}
+
+// Timeline returns the known lifecycle events of the region in date order.
+func (r Region) Timeline() []LifecycleEvent {
+	events := make([]LifecycleEvent, 0, 5)
+	for _, e := range []LifecycleEvent{
+		{EventAnnounced, r.Lifecycle.Announced},
+		{EventPreview, r.Lifecycle.Preview},
+		{EventGA, r.LaunchDate},
+		{EventDeprecationNotice, r.Lifecycle.DeprecationNotice},
+		{EventRetirement, r.Lifecycle.Retirement},
+	} {
+		if !e.Date.IsZero() {
+			events = append(events, e)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Date.Before(events[j].Date)
+	})
+	return events
+}
+
+// StatusAt returns the status the region had at time t, derived from its
+// lifecycle. Launched regions keep their opt-in, restricted or preview
+// status, and a deprecated region without a dated deprecation notice stays
+// deprecated, so AsOf(time.Now()) agrees with Status. The result is false
+// when the region was not yet usable (Announced) or already shut down
+// (Retired) at t.
+func (r Region) StatusAt(t time.Time) (Status, bool) {
+	l := r.Lifecycle
+	switch {
+	case r.Status == Retired && l.Retirement.IsZero(), !l.Retirement.IsZero() && !t.Before(l.Retirement):
+		return Retired, false
+	case !l.DeprecationNotice.IsZero() && !t.Before(l.DeprecationNotice):
+		return Deprecated, true
+	case r.Status == Announced:
+		return Announced, false
+	case r.LaunchDate.IsZero() || !t.Before(r.LaunchDate):
+		switch {
+		case r.Status == OptIn, r.Status == Restricted, r.Status == Preview:
+			return r.Status, true
+		case r.Status == Deprecated && l.DeprecationNotice.IsZero():
+			return Deprecated, true
+		}
+		return Active, true
+	case !l.Preview.IsZero() && !t.Before(l.Preview):
+		return Preview, true
+	default:
+		return Announced, false
+	}
+}
+
+// AvailableAt reports whether the region was usable, in preview or later,
+// and not yet retired at time t.
+func (r Region) AvailableAt(t time.Time) bool {
+	_, ok := r.StatusAt(t)
+	return ok
+}
+
+// AsOf returns the regions of the set that were available at time t, each
+// with its Status as of t.
+func (s Set) AsOf(t time.Time) Set {
+	result := make(Set, 0, len(s))
+	for _, r := range s {
+		if status, ok := r.StatusAt(t); ok {
+			r.Status = status
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// RetiringBetween filters regions whose retirement date falls in [from, to).
+func (s Set) RetiringBetween(from, to time.Time) Set {
+	return s.Filter(func(r Region) bool {
+		retirement := r.Lifecycle.Retirement
+		return !retirement.IsZero() && !retirement.Before(from) && retirement.Before(to)
+	})
+}
+
+// AsOf answers "where was available on {date}?" - returns the catalog as it
+// stood at time t, including isolated partitions, with each region's Status
+// as of t.
+func AsOf(t time.Time) Set {
+	return allRegions().AsOf(t)
+}
@@ -0,0 +1,142 @@
+package where
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestStatusAt(t *testing.T) {
+	r := Region{
+		Code:       "test-1",
+		Provider:   "test",
+		Status:     Deprecated,
+		LaunchDate: date(2020, 6, 1),
+		Lifecycle: Lifecycle{
+			Announced:         date(2019, 1, 1),
+			Preview:           date(2020, 1, 1),
+			DeprecationNotice: date(2024, 1, 1),
+			Retirement:        date(2025, 1, 1),
+		},
+	}
+
+	tests := []struct {
+		at        time.Time
+		expected  Status
+		available bool
+	}{
+		{date(2019, 6, 1), Announced, false},
+		{date(2020, 3, 1), Preview, true},
+		{date(2020, 6, 1), Active, true},
+		{date(2024, 6, 1), Deprecated, true},
+		{date(2025, 1, 1), Retired, false},
+	}
+	for _, tt := range tests {
+		status, ok := r.StatusAt(tt.at)
+		if ok != tt.available || status != tt.expected {
+			t.Errorf("StatusAt(%v) = %v, %v, want %v, %v", tt.at.Format("2006-01-02"), status, ok, tt.expected, tt.available)
+		}
+	}
+
+	timeline := r.Timeline()
+	kinds := []EventKind{EventAnnounced, EventPreview, EventGA, EventDeprecationNotice, EventRetirement}
+	if len(timeline) != len(kinds) {
+		t.Fatalf("Timeline() returned %d events, want %d", len(timeline), len(kinds))
+	}
+	for i, kind := range kinds {
+		if timeline[i].Kind != kind {
+			t.Errorf("Timeline()[%d] = %v, want %v", i, timeline[i].Kind, kind)
+		}
+	}
+}
+
+func TestStatusAtUndated(t *testing.T) {
+	// Without dated milestones, launched regions keep their current status.
+	for _, status := range []Status{Active, Deprecated, Preview, OptIn, Restricted} {
+		r := Region{Code: "test-1", Provider: "test", Status: status, LaunchDate: date(2015, 1, 1)}
+		if got, ok := r.StatusAt(date(2026, 1, 1)); !ok || got != status {
+			t.Errorf("StatusAt() with status %v = %v, %v, want %v, true", status, got, ok, status)
+		}
+	}
+
+	// The catalog records no milestone after this date, so AsOf agrees with
+	// the current statuses.
+	for _, r := range AsOf(date(2026, 10, 1)) {
+		current, err := ByID(r.ID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Status != current.Status {
+			t.Errorf("AsOf(2026-10-01) gives %v status %v, want %v", r.ID(), r.Status, current.Status)
+		}
+	}
+}
+
+func TestAsOf(t *testing.T) {
+	then := AsOf(date(2020, 1, 1))
+	if len(then) == 0 || len(then) >= len(allRegions()) {
+		t.Fatalf("AsOf(2020-01-01) returned %d of %d regions", len(then), len(allRegions()))
+	}
+	for _, r := range then {
+		if r.LaunchDate.After(date(2020, 1, 1)) {
+			t.Errorf("AsOf(2020-01-01) includes %v launched %v", r.ID(), r.LaunchDate)
+		}
+	}
+	if !containsCode(then, "us-east-1") {
+		t.Error("AsOf(2020-01-01) should include us-east-1")
+	}
+	if containsCode(then, "il-central-1") {
+		t.Error("AsOf(2020-01-01) should not include il-central-1")
+	}
+
+	chinaEast, err := ByID("azure/chinaeast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !chinaEast.AvailableAt(date(2024, 1, 1)) || chinaEast.AvailableAt(date(2026, 8, 1)) {
+		t.Error("azure/chinaeast should be available before its retirement only")
+	}
+	if got := NewQuery().AsOf(date(2024, 1, 1)).DeprecatedOnly().Count(); got == 0 {
+		t.Error("AsOf(2024-01-01) should include deprecated Azure China regions")
+	}
+	deprecated := DeprecatedRegions()
+	if len(deprecated) == 0 {
+		t.Fatal("DeprecatedRegions() should not be empty")
+	}
+	if !containsCode(deprecated, "sfo1") {
+		t.Errorf("DeprecatedRegions() = %v, want digitalocean/sfo1 included", deprecated.Codes())
+	}
+	for _, r := range deprecated {
+		if r.Status != Deprecated {
+			t.Errorf("DeprecatedRegions() includes %v with status %v", r.ID(), r.Status)
+		}
+	}
+
+	optIn := MustIs("af-south-1")
+	if status, ok := optIn.StatusAt(date(2024, 1, 1)); !ok || status != OptIn {
+		t.Errorf("af-south-1 StatusAt() = %v, %v, want opt-in", status, ok)
+	}
+}
+
+func TestRetiringBetween(t *testing.T) {
+	from := date(2026, 1, 1)
+	retiring := NewQuery().RetiringBetween(from, from.AddDate(1, 0, 0)).Exec()
+	if !containsCode(retiring, "chinanorth") {
+		t.Errorf("RetiringBetween() = %v, want chinanorth included", retiring.Codes())
+	}
+	if got := allRegions().RetiringBetween(date(2000, 1, 1), date(2001, 1, 1)); len(got) != 0 {
+		t.Errorf("RetiringBetween(2000, 2001) = %v, want none", got.Codes())
+	}
+}
+
+func containsCode(s Set, code Code) bool {
+	for _, r := range s {
+		if r.Code == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,366 @@
+package where
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Edge network names
+const (
+	NetworkCloudFront = "cloudfront"
+	NetworkCloudflare = "cloudflare"
+)
+
+// Location represents a CDN or edge point of presence. Unlike a Region it offers
+// no general-purpose compute; it terminates and caches traffic close to users.
+type Location struct {
+	Code      string  `json:"code"` // IATA airport code of the metro the PoP serves
+	Network   string  `json:"network"`
+	Provider  string  `json:"provider"`
+	Country   string  `json:"country"`
+	City      string  `json:"city"`
+	Continent string  `json:"continent"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Distance calculates the great-circle distance to a region in kilometers.
+func (l Location) Distance(r Region) float64 {
+	return haversineDistance(l.Latitude, l.Longitude, r.Latitude, r.Longitude)
+}
+
+// IsNear returns true if the location is within the specified radius (km) of the target location.
+func (l Location) IsNear(lat, lng float64, radiusKm float64) bool {
+	return haversineDistance(l.Latitude, l.Longitude, lat, lng) <= radiusKm
+}
+
+// LocationSet represents a collection of edge locations.
+type LocationSet []Location
+
+// Filter applies a predicate function to create a new filtered set.
+func (s LocationSet) Filter(predicate func(Location) bool) LocationSet {
+	result := make(LocationSet, 0, len(s))
+	for _, location := range s {
+		if predicate(location) {
+			result = append(result, location)
+		}
+	}
+	return result
+}
+
+// OnNetwork filters locations by edge network name.
+func (s LocationSet) OnNetwork(name string) LocationSet {
+	return s.Filter(func(l Location) bool {
+		return strings.EqualFold(l.Network, name)
+	})
+}
+
+// ByCountry filters locations by country name.
+func (s LocationSet) ByCountry(name string) LocationSet {
+	return s.Filter(func(l Location) bool {
+		return sameName(name, l.Country)
+	})
+}
+
+// ByCity filters locations by city name.
+func (s LocationSet) ByCity(name string) LocationSet {
+	return s.Filter(func(l Location) bool {
+		return sameName(name, l.City)
+	})
+}
+
+// ByContinent filters locations by continent name.
+func (s LocationSet) ByContinent(name string) LocationSet {
+	return s.Filter(func(l Location) bool {
+		return strings.EqualFold(l.Continent, name)
+	})
+}
+
+// Near filters locations within the specified radius of a point.
+func (s LocationSet) Near(lat, lng float64, radiusKm float64) LocationSet {
+	return s.Filter(func(l Location) bool {
+		return l.IsNear(lat, lng, radiusKm)
+	})
+}
+
+// SortByDistance sorts locations by distance from a point (closest first).
+func (s LocationSet) SortByDistance(lat, lng float64) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return haversineDistance(lat, lng, s[i].Latitude, s[i].Longitude) <
+			haversineDistance(lat, lng, s[j].Latitude, s[j].Longitude)
+	})
+}
+
+// Nearest returns the location closest to a point, or an error if the set is empty.
+func (s LocationSet) Nearest(lat, lng float64) (Location, error) {
+	if len(s) == 0 {
+		return Location{}, fmt.Errorf("no locations found")
+	}
+
+	var nearest Location
+	minDistance := math.MaxFloat64
+	for _, location := range s {
+		if d := haversineDistance(lat, lng, location.Latitude, location.Longitude); d < minDistance {
+			minDistance = d
+			nearest = location
+		}
+	}
+	return nearest, nil
+}
+
+// NearestTo returns the location closest to a region, e.g. the PoP fronting an origin.
+func (s LocationSet) NearestTo(r Region) (Location, error) {
+	return s.Nearest(r.Latitude, r.Longitude)
+}
+
+// Codes returns a slice of location codes from the set.
+func (s LocationSet) Codes() []string {
+	codes := make([]string, len(s))
+	for i, location := range s {
+		codes[i] = location.Code
+	}
+	return codes
+}
+
+// Len returns the number of locations in the set.
+func (s LocationSet) Len() int {
+	return len(s)
+}
+
+// NearestEdge returns the PoP of the given edge network closest to the region.
+func (r Region) NearestEdge(network string) (Location, error) {
+	return EdgeLocations().OnNetwork(network).NearestTo(r)
+}
+
+// EdgeLocations answers "where are the edges?" - returns all known edge locations.
+func EdgeLocations() LocationSet {
+	result := make(LocationSet, len(edgeLocations))
+	copy(result, edgeLocations)
+	return result
+}
+
+// EdgeNamespace provides queries over CDN and edge points of presence.
+// Usage: where.Edge.Cloudflare(), where.Edge.Near(lat, lng, radius)
+type EdgeNamespace struct{}
+
+// All returns every known edge location.
+func (EdgeNamespace) All() LocationSet {
+	return EdgeLocations()
+}
+
+// Network returns the locations of the specified edge network.
+func (EdgeNamespace) Network(name string) LocationSet {
+	return EdgeLocations().OnNetwork(name)
+}
+
+// CloudFront returns the Amazon CloudFront regional edge caches.
+func (EdgeNamespace) CloudFront() LocationSet {
+	return EdgeLocations().OnNetwork(NetworkCloudFront)
+}
+
+// Cloudflare returns all Cloudflare data centers.
+func (EdgeNamespace) Cloudflare() LocationSet {
+	return EdgeLocations().OnNetwork(NetworkCloudflare)
+}
+
+// InCountry returns all edge locations in the specified country.
+func (EdgeNamespace) InCountry(name string) LocationSet {
+	return EdgeLocations().ByCountry(name)
+}
+
+// InCity returns all edge locations in the specified city.
+func (EdgeNamespace) InCity(name string) LocationSet {
+	return EdgeLocations().ByCity(name)
+}
+
+// Near returns edge locations within the specified radius of coordinates.
+func (EdgeNamespace) Near(lat, lng, radiusKm float64) LocationSet {
+	return EdgeLocations().Near(lat, lng, radiusKm)
+}
+
+// NearRegion returns edge locations within the specified radius of a region.
+func (EdgeNamespace) NearRegion(code Code, radiusKm float64) (LocationSet, error) {
+	region, err := Is(code).First()
+	if err != nil {
+		return nil, err
+	}
+	return EdgeLocations().Near(region.Latitude, region.Longitude, radiusKm), nil
+}
+
+// Edge provides CDN and edge point-of-presence queries.
+var Edge EdgeNamespace
+
+// edgeMetro describes the metro area an IATA code refers to.
+type edgeMetro struct {
+	City      string
+	Country   string
+	Continent string
+	Latitude  float64
+	Longitude float64
+}
+
+var edgeMetros = map[string]edgeMetro{
+	// North America
+	"IAD": {"Ashburn", "United States", ContinentNorthAmerica, 38.9519, -77.4480},
+	"EWR": {"Newark", "United States", ContinentNorthAmerica, 40.6895, -74.1745},
+	"BOS": {"Boston", "United States", ContinentNorthAmerica, 42.3656, -71.0096},
+	"ATL": {"Atlanta", "United States", ContinentNorthAmerica, 33.6407, -84.4277},
+	"MIA": {"Miami", "United States", ContinentNorthAmerica, 25.7959, -80.2870},
+	"ORD": {"Chicago", "United States", ContinentNorthAmerica, 41.9742, -87.9073},
+	"DFW": {"Dallas", "United States", ContinentNorthAmerica, 32.8998, -97.0403},
+	"DEN": {"Denver", "United States", ContinentNorthAmerica, 39.8561, -104.6737},
+	"LAX": {"Los Angeles", "United States", ContinentNorthAmerica, 33.9416, -118.4085},
+	"SJC": {"San Jose", "United States", ContinentNorthAmerica, 37.3639, -121.9289},
+	"SEA": {"Seattle", "United States", ContinentNorthAmerica, 47.4502, -122.3088},
+	"YYZ": {"Toronto", "Canada", ContinentNorthAmerica, 43.6777, -79.6248},
+	"YUL": {"Montréal", "Canada", ContinentNorthAmerica, 45.4706, -73.7408},
+	"YVR": {"Vancouver", "Canada", ContinentNorthAmerica, 49.1967, -123.1815},
+	"MEX": {"Mexico City", "Mexico", ContinentNorthAmerica, 19.4361, -99.0719},
+	"PTY": {"Panama City", "Panama", ContinentNorthAmerica, 9.0714, -79.3835},
+	"SJO": {"San José", "Costa Rica", ContinentNorthAmerica, 9.9981, -84.2041},
+	"CMH": {"Columbus", "United States", ContinentNorthAmerica, 39.9980, -82.8919},
+	"SFO": {"San Francisco", "United States", ContinentNorthAmerica, 37.6213, -122.3790},
+	"PDX": {"Portland", "United States", ContinentNorthAmerica, 45.5898, -122.5951},
+	// South America
+	"GRU": {"São Paulo", "Brazil", ContinentSouthAmerica, -23.4356, -46.4731},
+	"GIG": {"Rio de Janeiro", "Brazil", ContinentSouthAmerica, -22.8090, -43.2506},
+	"EZE": {"Buenos Aires", "Argentina", ContinentSouthAmerica, -34.8222, -58.5358},
+	"SCL": {"Santiago", "Chile", ContinentSouthAmerica, -33.3930, -70.7858},
+	"BOG": {"Bogotá", "Colombia", ContinentSouthAmerica, 4.7016, -74.1469},
+	"LIM": {"Lima", "Peru", ContinentSouthAmerica, -12.0219, -77.1143},
+	"UIO": {"Quito", "Ecuador", ContinentSouthAmerica, -0.1292, -78.3575},
+	"MVD": {"Montevideo", "Uruguay", ContinentSouthAmerica, -34.8384, -56.0308},
+	"ASU": {"Asunción", "Paraguay", ContinentSouthAmerica, -25.2400, -57.5191},
+	// Europe
+	"LHR": {"London", "United Kingdom", ContinentEurope, 51.4700, -0.4543},
+	"MAN": {"Manchester", "United Kingdom", ContinentEurope, 53.3650, -2.2720},
+	"DUB": {"Dublin", "Ireland", ContinentEurope, 53.4264, -6.2499},
+	"CDG": {"Paris", "France", ContinentEurope, 49.0097, 2.5479},
+	"MRS": {"Marseille", "France", ContinentEurope, 43.4393, 5.2214},
+	"FRA": {"Frankfurt", "Germany", ContinentEurope, 50.0379, 8.5622},
+	"MUC": {"Munich", "Germany", ContinentEurope, 48.3537, 11.7750},
+	"HAM": {"Hamburg", "Germany", ContinentEurope, 53.6304, 9.9882},
+	"AMS": {"Amsterdam", "Netherlands", ContinentEurope, 52.3105, 4.7683},
+	"BRU": {"Brussels", "Belgium", ContinentEurope, 50.9010, 4.4856},
+	"ZRH": {"Zurich", "Switzerland", ContinentEurope, 47.4582, 8.5555},
+	"VIE": {"Vienna", "Austria", ContinentEurope, 48.1103, 16.5697},
+	"MXP": {"Milan", "Italy", ContinentEurope, 45.6306, 8.7281},
+	"FCO": {"Rome", "Italy", ContinentEurope, 41.8003, 12.2389},
+	"MAD": {"Madrid", "Spain", ContinentEurope, 40.4983, -3.5676},
+	"BCN": {"Barcelona", "Spain", ContinentEurope, 41.2974, 2.0833},
+	"LIS": {"Lisbon", "Portugal", ContinentEurope, 38.7742, -9.1342},
+	"ARN": {"Stockholm", "Sweden", ContinentEurope, 59.6498, 17.9238},
+	"CPH": {"Copenhagen", "Denmark", ContinentEurope, 55.6180, 12.6508},
+	"OSL": {"Oslo", "Norway", ContinentEurope, 60.1976, 11.1004},
+	"HEL": {"Helsinki", "Finland", ContinentEurope, 60.3172, 24.9633},
+	"KEF": {"Reykjavík", "Iceland", ContinentEurope, 63.9850, -22.6056},
+	"WAW": {"Warsaw", "Poland", ContinentEurope, 52.1657, 20.9671},
+	"PRG": {"Prague", "Czech Republic", ContinentEurope, 50.1008, 14.2600},
+	"BUD": {"Budapest", "Hungary", ContinentEurope, 47.4298, 19.2611},
+	"OTP": {"Bucharest", "Romania", ContinentEurope, 44.5711, 26.0850},
+	"ATH": {"Athens", "Greece", ContinentEurope, 37.9364, 23.9445},
+	"IST": {"Istanbul", "Turkey", ContinentEurope, 41.2753, 28.7519},
+	"KBP": {"Kyiv", "Ukraine", ContinentEurope, 50.3450, 30.8947},
+	// Middle East
+	"DXB": {"Dubai", "United Arab Emirates", ContinentAsia, 25.2532, 55.3657},
+	"DOH": {"Doha", "Qatar", ContinentAsia, 25.2731, 51.6081},
+	"BAH": {"Manama", "Bahrain", ContinentAsia, 26.2708, 50.6336},
+	"TLV": {"Tel Aviv", "Israel", ContinentAsia, 32.0055, 34.8854},
+	"RUH": {"Riyadh", "Saudi Arabia", ContinentAsia, 24.9576, 46.6988},
+	"JED": {"Jeddah", "Saudi Arabia", ContinentAsia, 21.6796, 39.1565},
+	"AMM": {"Amman", "Jordan", ContinentAsia, 31.7226, 35.9932},
+	"MCT": {"Muscat", "Oman", ContinentAsia, 23.5933, 58.2844},
+	"TBS": {"Tbilisi", "Georgia", ContinentAsia, 41.6692, 44.9547},
+	// Africa
+	"JNB": {"Johannesburg", "South Africa", ContinentAfrica, -26.1367, 28.2411},
+	"CPT": {"Cape Town", "South Africa", ContinentAfrica, -33.9715, 18.6021},
+	"LOS": {"Lagos", "Nigeria", ContinentAfrica, 6.5774, 3.3212},
+	"NBO": {"Nairobi", "Kenya", ContinentAfrica, -1.3192, 36.9278},
+	"CAI": {"Cairo", "Egypt", ContinentAfrica, 30.1219, 31.4056},
+	"CMN": {"Casablanca", "Morocco", ContinentAfrica, 33.3675, -7.5900},
+	"ACC": {"Accra", "Ghana", ContinentAfrica, 5.6052, -0.1668},
+	"KGL": {"Kigali", "Rwanda", ContinentAfrica, -1.9686, 30.1395},
+	"DAR": {"Dar es Salaam", "Tanzania", ContinentAfrica, -6.8781, 39.2026},
+	// Asia
+	"BOM": {"Mumbai", "India", ContinentAsia, 19.0896, 72.8656},
+	"DEL": {"Delhi", "India", ContinentAsia, 28.5562, 77.1000},
+	"MAA": {"Chennai", "India", ContinentAsia, 12.9941, 80.1709},
+	"BLR": {"Bangalore", "India", ContinentAsia, 13.1986, 77.7066},
+	"HYD": {"Hyderabad", "India", ContinentAsia, 17.2403, 78.4294},
+	"KHI": {"Karachi", "Pakistan", ContinentAsia, 24.9065, 67.1608},
+	"DAC": {"Dhaka", "Bangladesh", ContinentAsia, 23.8433, 90.3978},
+	"KTM": {"Kathmandu", "Nepal", ContinentAsia, 27.6966, 85.3591},
+	"CMB": {"Colombo", "Sri Lanka", ContinentAsia, 7.1808, 79.8841},
+	"SIN": {"Singapore", "Singapore", ContinentAsia, 1.3644, 103.9915},
+	"KUL": {"Kuala Lumpur", "Malaysia", ContinentAsia, 2.7456, 101.7072},
+	"BKK": {"Bangkok", "Thailand", ContinentAsia, 13.6900, 100.7501},
+	"CGK": {"Jakarta", "Indonesia", ContinentAsia, -6.1256, 106.6559},
+	"MNL": {"Manila", "Philippines", ContinentAsia, 14.5086, 121.0194},
+	"HKG": {"Hong Kong", "Hong Kong", ContinentAsia, 22.3080, 113.9185},
+	"TPE": {"Taipei", "Taiwan", ContinentAsia, 25.0797, 121.2342},
+	"NRT": {"Tokyo", "Japan", ContinentAsia, 35.7720, 140.3929},
+	"KIX": {"Osaka", "Japan", ContinentAsia, 34.4320, 135.2304},
+	"ICN": {"Seoul", "South Korea", ContinentAsia, 37.4602, 126.4407},
+	"SGN": {"Ho Chi Minh City", "Vietnam", ContinentAsia, 10.8188, 106.6520},
+	"HAN": {"Hanoi", "Vietnam", ContinentAsia, 21.2212, 105.8072},
+	"PNH": {"Phnom Penh", "Cambodia", ContinentAsia, 11.5466, 104.8441},
+	"ULN": {"Ulaanbaatar", "Mongolia", ContinentAsia, 47.6467, 106.8198},
+	"ALA": {"Almaty", "Kazakhstan", ContinentAsia, 43.3521, 77.0405},
+	// Oceania
+	"SYD": {"Sydney", "Australia", ContinentOceania, -33.9399, 151.1753},
+	"MEL": {"Melbourne", "Australia", ContinentOceania, -37.6690, 144.8410},
+	"BNE": {"Brisbane", "Australia", ContinentOceania, -27.3942, 153.1218},
+	"PER": {"Perth", "Australia", ContinentOceania, -31.9385, 115.9672},
+	"AKL": {"Auckland", "New Zealand", ContinentOceania, -37.0082, 174.7850},
+}
+
+// edgeNetworks lists the metros each edge network has a PoP in, and the
+// provider that operates the network. CloudFront is listed by its regional
+// edge caches, the mid-tier caches the CloudFront Developer Guide names per
+// AWS region, not by its several hundred edge locations. Cloudflare is listed
+// by the data centers of its status page (www.cloudflarestatus.com), which
+// names each one by IATA code, keeping major metros only. Azure Front Door
+// and Google Cloud CDN are left out until their PoP lists are sourced.
+var edgeNetworks = []struct {
+	Network  string
+	Provider string
+	Metros   []string
+}{
+	{NetworkCloudFront, ProviderAWS, []string{
+		"IAD", "CMH", "SFO", "PDX", "GRU", "DUB", "LHR", "FRA", "BOM", "SIN", "ICN", "NRT", "SYD",
+	}},
+	{NetworkCloudflare, ProviderCloudflare, []string{
+		"IAD", "EWR", "BOS", "ATL", "MIA", "ORD", "DFW", "DEN", "LAX", "SJC", "SEA", "YYZ", "YUL", "YVR", "MEX", "PTY", "SJO",
+		"GRU", "GIG", "EZE", "SCL", "BOG", "LIM", "UIO", "MVD", "ASU",
+		"LHR", "MAN", "DUB", "CDG", "MRS", "FRA", "MUC", "HAM", "AMS", "BRU", "ZRH", "VIE", "MXP", "FCO", "MAD", "BCN", "LIS", "ARN", "CPH", "OSL", "HEL", "KEF", "WAW", "PRG", "BUD", "OTP", "ATH", "IST", "KBP",
+		"DXB", "DOH", "BAH", "TLV", "RUH", "JED", "AMM", "MCT", "TBS",
+		"JNB", "CPT", "LOS", "NBO", "CAI", "CMN", "ACC", "KGL", "DAR",
+		"BOM", "DEL", "MAA", "BLR", "HYD", "KHI", "DAC", "KTM", "CMB", "SIN", "KUL", "BKK", "CGK", "MNL", "HKG", "TPE", "NRT", "KIX", "ICN", "SGN", "HAN", "PNH", "ULN", "ALA",
+		"SYD", "MEL", "BNE", "PER", "AKL",
+	}},
+}
+
+var edgeLocations = func() LocationSet {
+	var result LocationSet
+	for _, network := range edgeNetworks {
+		for _, code := range network.Metros {
+			metro, ok := edgeMetros[code]
+			if !ok {
+				panic("where: unknown edge metro " + code)
+			}
+			result = append(result, Location{
+				Code:      code,
+				Network:   network.Network,
+				Provider:  network.Provider,
+				Country:   metro.Country,
+				City:      metro.City,
+				Continent: metro.Continent,
+				Latitude:  metro.Latitude,
+				Longitude: metro.Longitude,
+			})
+		}
+	}
+	return result
+}()
@@ -0,0 +1,85 @@
+package where
+
+import "testing"
+
+func TestEdgeNetworks(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      LocationSet
+		provider string
+	}{
+		{NetworkCloudFront, Edge.CloudFront(), ProviderAWS},
+		{NetworkCloudflare, Edge.Cloudflare(), ProviderCloudflare},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.set) == 0 {
+				t.Fatal("expected edge locations")
+			}
+			for _, l := range tt.set {
+				if l.Network != tt.name || l.Provider != tt.provider {
+					t.Errorf("unexpected location %+v", l)
+				}
+			}
+		})
+	}
+}
+
+func TestEdgeGeographicFilters(t *testing.T) {
+	japan := Edge.InCountry("Japan")
+	if len(japan) == 0 {
+		t.Fatal("expected edge locations in Japan")
+	}
+	for _, l := range japan {
+		if l.Country != "Japan" {
+			t.Errorf("expected Japan, got %v", l.Country)
+		}
+	}
+
+	// Within 300km of Frankfurt
+	near := Edge.Near(50.1109, 8.6821, 300)
+	for _, l := range near {
+		if !l.IsNear(50.1109, 8.6821, 300) {
+			t.Errorf("location %v is not near Frankfurt", l.Code)
+		}
+	}
+	if len(near.OnNetwork(NetworkCloudflare).ByCity("Frankfurt")) != 1 {
+		t.Error("expected one Cloudflare PoP in Frankfurt")
+	}
+	if len(Edge.InCity("Frankfurt am Main")) != 2 || len(Edge.InCountry("Deutschland")) == 0 {
+		t.Error("expected edge filters to accept the names Set filters accept")
+	}
+
+	if _, err := Edge.NearRegion("invalid-region", 100); err == nil {
+		t.Error("NearRegion() should fail for unknown regions")
+	}
+}
+
+func TestNearestEdge(t *testing.T) {
+	region, err := Is("eu-central-1").OnAWS()
+	if err != nil {
+		t.Fatalf("Is() failed: %v", err)
+	}
+
+	pop, err := region.NearestEdge(NetworkCloudFront)
+	if err != nil {
+		t.Fatalf("NearestEdge() failed: %v", err)
+	}
+	if pop.Code != "FRA" {
+		t.Errorf("expected FRA to front eu-central-1, got %v", pop.Code)
+	}
+	if d := pop.Distance(region); d > 50 {
+		t.Errorf("expected FRA within 50km of eu-central-1, got %.1fkm", d)
+	}
+
+	if _, err := region.NearestEdge("unknown"); err == nil {
+		t.Error("NearestEdge() should fail for unknown networks")
+	}
+
+	sorted := Edge.Cloudflare()
+	sorted.SortByDistance(region.Latitude, region.Longitude)
+	if sorted[0].Code != "FRA" {
+		t.Errorf("expected FRA first after sorting, got %v", sorted[0].Code)
+	}
+}
@@ -0,0 +1,118 @@
+package where
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+)
+
+// geoFeature is a GeoJSON point feature.
+type geoFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoPoint               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// geoPoint is a GeoJSON point; coordinates are [longitude, latitude].
+type geoPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// GeoJSON encodes the set as a GeoJSON FeatureCollection of points, one per
+// region, with the region's ID, code, provider, name, location, status and
+// partition as properties.
+func (s Set) GeoJSON() ([]byte, error) {
+	features := make([]geoFeature, len(s))
+	for i, r := range s {
+		properties := map[string]interface{}{
+			"id":        r.ID(),
+			"code":      r.Code,
+			"provider":  r.Provider,
+			"name":      r.Name,
+			"country":   r.Country,
+			"city":      r.City,
+			"continent": r.Continent,
+			"status":    r.Status,
+			"partition": r.Partition,
+			"zones":     len(r.Zones),
+		}
+		if !r.LaunchDate.IsZero() {
+			properties["launch_date"] = r.LaunchDate.Format("2006-01-02")
+		}
+		features[i] = geoFeature{
+			Type:       "Feature",
+			Geometry:   geoPoint{Type: "Point", Coordinates: [2]float64{r.Longitude, r.Latitude}},
+			Properties: properties,
+		}
+	}
+	return json.Marshal(struct {
+		Type     string       `json:"type"`
+		Features []geoFeature `json:"features"`
+	}{"FeatureCollection", features})
+}
+
+// kmlPlacemark is a KML placemark for a region.
+type kmlPlacemark struct {
+	ID          string `xml:"id,attr"`
+	Name        string `xml:"name"`
+	Description string `xml:"description"`
+	Coordinates string `xml:"Point>coordinates"`
+}
+
+// WriteKML writes the set as a KML document with one placemark per region,
+// for Google Earth and other GIS tools.
+func (s Set) WriteKML(w io.Writer) error {
+	doc := struct {
+		XMLName    xml.Name       `xml:"kml"`
+		Namespace  string         `xml:"xmlns,attr"`
+		Name       string         `xml:"Document>name"`
+		Placemarks []kmlPlacemark `xml:"Document>Placemark"`
+	}{Namespace: "http://www.opengis.net/kml/2.2", Name: "Regions"}
+	for _, r := range s {
+		doc.Placemarks = append(doc.Placemarks, kmlPlacemark{
+			ID:          string(r.ID()),
+			Name:        fmt.Sprintf("%s %s", r.Provider, r.Code),
+			Description: fmt.Sprintf("%s, %s, %s (%s)", r.Name, r.City, r.Country, r.Status),
+			Coordinates: fmt.Sprintf("%g,%g,0", r.Longitude, r.Latitude),
+		})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// greatCircle returns n+1 points evenly spaced along the great circle from
+// one location to another, both included, as [latitude, longitude] pairs.
+func greatCircle(lat1, lng1, lat2, lng2 float64, n int) [][2]float64 {
+	const rad = math.Pi / 180
+	p1, l1, p2, l2 := lat1*rad, lng1*rad, lat2*rad, lng2*rad
+	d := haversineDistance(lat1, lng1, lat2, lng2) / 6371.0
+	points := make([][2]float64, 0, n+1)
+	for i := 0; i <= n; i++ {
+		f := float64(i) / float64(n)
+		if d == 0 {
+			points = append(points, [2]float64{lat1, lng1})
+			continue
+		}
+		a := math.Sin((1-f)*d) / math.Sin(d)
+		b := math.Sin(f*d) / math.Sin(d)
+		x := a*math.Cos(p1)*math.Cos(l1) + b*math.Cos(p2)*math.Cos(l2)
+		y := a*math.Cos(p1)*math.Sin(l1) + b*math.Cos(p2)*math.Sin(l2)
+		z := a*math.Sin(p1) + b*math.Sin(p2)
+		points = append(points, [2]float64{
+			math.Atan2(z, math.Hypot(x, y)) / rad,
+			math.Atan2(y, x) / rad,
+		})
+	}
+	return points
+}
@@ -0,0 +1,122 @@
+package where
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestGeoJSON(t *testing.T) {
+	set := Set{MustIs("eu-north-1"), MustIs("sa-east-1")}
+	data, err := set.GeoJSON()
+	if err != nil {
+		t.Fatalf("GeoJSON() error = %v", err)
+	}
+	var fc struct {
+		Type     string
+		Features []struct {
+			Type     string
+			Geometry struct {
+				Type        string
+				Coordinates []float64
+			}
+			Properties map[string]interface{}
+		}
+	}
+	if err := json.Unmarshal(data, &fc); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if fc.Type != "FeatureCollection" || len(fc.Features) != 2 {
+		t.Fatalf("GeoJSON() = %s", data)
+	}
+	f := fc.Features[1]
+	if f.Geometry.Type != "Point" || f.Geometry.Coordinates[0] != set[1].Longitude || f.Geometry.Coordinates[1] != set[1].Latitude {
+		t.Errorf("geometry = %+v, want [lng, lat] of sa-east-1", f.Geometry)
+	}
+	if f.Properties["id"] != "aws/sa-east-1" || f.Properties["status"] != "active" || f.Properties["provider"] != "aws" {
+		t.Errorf("properties = %v", f.Properties)
+	}
+}
+
+func TestWriteKML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (Set{MustIs("eu-north-1")}).WriteKML(&buf); err != nil {
+		t.Fatalf("WriteKML() error = %v", err)
+	}
+	var doc struct {
+		Placemarks []struct {
+			ID          string `xml:"id,attr"`
+			Name        string `xml:"name"`
+			Coordinates string `xml:"Point>coordinates"`
+		} `xml:"Document>Placemark"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v\n%s", err, buf.String())
+	}
+	if len(doc.Placemarks) != 1 || doc.Placemarks[0].ID != "aws/eu-north-1" || !strings.HasSuffix(doc.Placemarks[0].Coordinates, ",0") {
+		t.Errorf("WriteKML() = %s", buf.String())
+	}
+}
+
+func TestGreatCircle(t *testing.T) {
+	// London to New York: ends match and the midpoint lies north of both.
+	path := greatCircle(51.5, -0.1, 40.7, -74.0, 10)
+	if len(path) != 11 {
+		t.Fatalf("greatCircle() returned %d points, want 11", len(path))
+	}
+	if math.Abs(path[0][0]-51.5) > 1e-9 || math.Abs(path[10][1]+74.0) > 1e-9 {
+		t.Errorf("greatCircle() ends = %v, %v", path[0], path[10])
+	}
+	if path[5][0] < 51.5 {
+		t.Errorf("greatCircle() midpoint latitude = %.1f, want north of 51.5", path[5][0])
+	}
+
+	// Tokyo to San Francisco crosses the antimeridian.
+	segments := splitAntimeridian(greatCircle(35.7, 139.7, 37.8, -122.4, 32))
+	if len(segments) != 2 {
+		t.Errorf("splitAntimeridian() = %d segments, want 2", len(segments))
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	set := Set{MustIs("eu-north-1"), MustIs("ap-northeast-1")}
+	gcp, _ := Is("us-west1").OnGCP()
+	set = append(set, gcp)
+
+	var buf bytes.Buffer
+	err := set.WriteSVG(&buf, MapOptions{
+		Width: 800,
+		Title: "Deployments <prod>",
+		Links: []Link{{From: "aws/ap-northeast-1", To: "gcp/us-west1"}, {From: "aws/eu-north-1", To: "aws/us-east-1"}},
+	})
+	if err != nil {
+		t.Fatalf("WriteSVG() error = %v", err)
+	}
+	out := buf.String()
+	if err := xml.Unmarshal(buf.Bytes(), new(struct{})); err != nil {
+		t.Errorf("WriteSVG() output is not well-formed XML: %v", err)
+	}
+	for _, want := range []string{`width="800" height="400"`, "Deployments &lt;prod&gt;", providerColors["aws"], providerColors["gcp"], ">aws</text>"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("WriteSVG() output lacks %q", want)
+		}
+	}
+	// Three polylines: the Pacific link is split at the antimeridian.
+	if got := strings.Count(out, "<polyline"); got != 3 {
+		t.Errorf("WriteSVG() drew %d polylines, want 3", got)
+	}
+
+	buf.Reset()
+	if err := set.WriteSVG(&buf, MapOptions{ColorBy: ColorByStatus}); err != nil || !strings.Contains(buf.String(), ">active</text>") {
+		t.Errorf("WriteSVG(ColorByStatus) error = %v, legend missing", err)
+	}
+
+	err = set.WriteSVG(&buf, MapOptions{Links: []Link{{From: "aws/eu-north-1", To: "aws/mars-1"}}})
+	if !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("WriteSVG(unknown link) error = %v, want ErrRegionNotFound", err)
+	}
+}
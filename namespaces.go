@@ -12,11 +12,20 @@ const (
 
 // Provider names
 const (
-	ProviderAWS     = "aws"
-	ProviderAzure   = "azure"
-	ProviderGCP     = "gcp"
-	ProviderYandex  = "yandex"
-	ProviderAlibaba = "alibaba"
+	ProviderAWS          = "aws"
+	ProviderAzure        = "azure"
+	ProviderGCP          = "gcp"
+	ProviderYandex       = "yandex"
+	ProviderAlibaba      = "alibaba"
+	ProviderDigitalOcean = "digitalocean"
+	ProviderLinode       = "linode"
+	ProviderVultr        = "vultr"
+	ProviderFly          = "fly"
+	ProviderTencent      = "tencent"
+	ProviderHuawei       = "huawei"
+
+	// ProviderCloudflare operates an edge network only; it has no regions.
+	ProviderCloudflare = "cloudflare"
 )
 
 // InNamespace provides geographic-based region queries.
@@ -66,6 +75,11 @@ func (InNamespace) Continent(name string) Set {
 	return InContinent(name)
 }
 
+// Partition returns all regions in the specified partitions.
+func (InNamespace) Partition(partitions ...Partition) Set {
+	return InPartition(partitions...)
+}
+
 // OnNamespace provides provider-based region queries.
 // Usage: where.On.AWS(), where.On.Azure(), where.On.Provider("gcp")
 type OnNamespace struct {
@@ -96,6 +110,36 @@ func (OnNamespace) Alibaba() Set {
 	return OnProvider(ProviderAlibaba)
 }
 
+// DigitalOcean returns all DigitalOcean regions.
+func (OnNamespace) DigitalOcean() Set {
+	return OnProvider(ProviderDigitalOcean)
+}
+
+// Linode returns all Akamai Cloud (Linode) regions.
+func (OnNamespace) Linode() Set {
+	return OnProvider(ProviderLinode)
+}
+
+// Vultr returns all Vultr regions.
+func (OnNamespace) Vultr() Set {
+	return OnProvider(ProviderVultr)
+}
+
+// Fly returns all Fly.io regions.
+func (OnNamespace) Fly() Set {
+	return OnProvider(ProviderFly)
+}
+
+// Tencent returns all Tencent Cloud regions.
+func (OnNamespace) Tencent() Set {
+	return OnProvider(ProviderTencent)
+}
+
+// Huawei returns all Huawei Cloud regions.
+func (OnNamespace) Huawei() Set {
+	return OnProvider(ProviderHuawei)
+}
+
 // Provider returns all regions from the specified provider.
 func (OnNamespace) Provider(name string) Set {
 	return OnProvider(name)
@@ -149,16 +193,19 @@ func (NearNamespace) Region(code Code, radiusKm float64) (Set, error) {
 	return Near(region.Latitude, region.Longitude, radiusKm), nil
 }
 
-// City returns regions within the specified radius of a city.
+// City returns regions within the specified radius of a city: a region city
+// or any major city of the embedded dataset. An unknown city yields an empty set.
 func (NearNamespace) City(cityName string, radiusKm float64) Set {
-	// Find regions in the city first, then find regions near those coordinates
-	cityRegions := InCity(cityName)
-	if len(cityRegions) == 0 {
+	lat, lng, err := locateCity(cityName)
+	if err != nil {
 		return Set{}
 	}
-	// Use the first city region as reference point
-	ref := cityRegions[0]
-	return Near(ref.Latitude, ref.Longitude, radiusKm)
+	return Near(lat, lng, radiusKm)
+}
+
+// Zones returns availability, local and wavelength zones within the specified radius of coordinates.
+func (NearNamespace) Zones(lat, lng, radiusKm float64) ZoneSet {
+	return NearZones(lat, lng, radiusKm)
 }
 
 // Global namespace instances
@@ -0,0 +1,181 @@
+package where
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// PriceIndex is the cost of running in a region relative to a provider's
+// reference region, where 1.0 means the same price. Compute is the relative
+// price of a reference compute unit, Storage of object storage per GB and
+// Egress of internet data transfer out per GB.
+type PriceIndex struct {
+	Compute float64 `json:"compute"`
+	Storage float64 `json:"storage"`
+	Egress  float64 `json:"egress"`
+}
+
+// PriceTable maps regions to their price indexes. A table is immutable once
+// built and safe for concurrent use.
+type PriceTable struct {
+	prices map[RegionID]PriceIndex
+}
+
+// NewPriceTable builds a table from a map of region IDs to price indexes.
+func NewPriceTable(prices map[RegionID]PriceIndex) *PriceTable {
+	t := &PriceTable{prices: make(map[RegionID]PriceIndex, len(prices))}
+	for id, p := range prices {
+		t.prices[id] = p
+	}
+	return t
+}
+
+// ReadPriceTable reads a table from JSON mapping region IDs to indexes, e.g.
+// {"aws/sa-east-1": {"compute": 1.59, "storage": 1.76, "egress": 1.67}}.
+func ReadPriceTable(r io.Reader) (*PriceTable, error) {
+	t := &PriceTable{}
+	if err := json.NewDecoder(r).Decode(t); err != nil {
+		return nil, fmt.Errorf("read price table: %w", err)
+	}
+	return t, nil
+}
+
+// LoadPriceTable reads a table from a JSON file; see ReadPriceTable.
+func LoadPriceTable(path string) (*PriceTable, error) {
+	return loadFile(path, ReadPriceTable)
+}
+
+// Price returns the price index of a region, if the table has one.
+func (t *PriceTable) Price(id RegionID) (PriceIndex, bool) {
+	p, ok := t.prices[id]
+	return p, ok
+}
+
+// MarshalJSON encodes the table in the format read by ReadPriceTable.
+func (t *PriceTable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.prices)
+}
+
+// UnmarshalJSON decodes a table in the format read by ReadPriceTable.
+func (t *PriceTable) UnmarshalJSON(data []byte) error {
+	prices, err := readRegionMap(bytes.NewReader(data), func(id RegionID, p PriceIndex) error {
+		if p.Compute < 0 || p.Storage < 0 || p.Egress < 0 {
+			return fmt.Errorf("negative index for %s", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	t.prices = prices
+	return nil
+}
+
+// priceData is the table consulted by Price, MaxPriceIndex and SortByPrice.
+var priceData = dataset[PriceTable]{baseline: baselinePrices}
+
+// UsePriceTable replaces the table consulted by Region.Price and the price
+// filters and sorts. Passing nil restores the baseline shipped with the package.
+func UsePriceTable(t *PriceTable) {
+	priceData.use(t)
+}
+
+// CurrentPriceTable returns the table in use, the baseline unless
+// UsePriceTable installed another one.
+func CurrentPriceTable() *PriceTable {
+	return priceData.current()
+}
+
+// Price returns the price index of the region from the current price table.
+func (r Region) Price() (PriceIndex, bool) {
+	return CurrentPriceTable().Price(r.ID())
+}
+
+// MaxPriceIndex filters regions whose compute index is at most maxIndex.
+// Regions without price data are dropped.
+func (s Set) MaxPriceIndex(maxIndex float64) Set {
+	table := CurrentPriceTable()
+	return s.Filter(func(r Region) bool {
+		p, ok := table.Price(r.ID())
+		return ok && p.Compute <= maxIndex
+	})
+}
+
+// SortByPrice sorts regions by compute index (cheapest first). Regions
+// without price data sort last.
+func (s Set) SortByPrice() {
+	table := CurrentPriceTable()
+	s.sortByScore(func(r Region) float64 {
+		if p, ok := table.Price(r.ID()); ok {
+			return p.Compute
+		}
+		return math.Inf(1)
+	})
+}
+
+// SortByDistanceAndPrice sorts regions by a combined cost of distance from a
+// location and compute price, cheapest first. kmPerIndex is how many
+// kilometers one full index point is worth: with 2000, a region 10% cheaper
+// ranks level with one 200 km closer. Regions without price data sort last.
+func (s Set) SortByDistanceAndPrice(lat, lng float64, kmPerIndex float64) {
+	table := CurrentPriceTable()
+	s.sortByScore(func(r Region) float64 {
+		p, ok := table.Price(r.ID())
+		if !ok {
+			return math.Inf(1)
+		}
+		return haversineDistance(lat, lng, r.Latitude, r.Longitude) + p.Compute*kmPerIndex
+	})
+}
+
+// sortByScore stably sorts regions by ascending score.
+func (s Set) sortByScore(score func(Region) float64) {
+	scores := make(map[RegionID]float64, len(s))
+	for _, r := range s {
+		scores[r.ID()] = score(r)
+	}
+	sort.SliceStable(s, func(i, j int) bool {
+		return scores[s[i].ID()] < scores[s[j].ID()]
+	})
+}
+
+// baselinePrices holds approximate list-price ratios for AWS commercial
+// regions relative to us-east-1: on-demand general purpose Linux instances,
+// S3 Standard storage and the first internet egress tier. Prices change and
+// differ by service; load current figures for purchasing decisions.
+var baselinePrices = NewPriceTable(map[RegionID]PriceIndex{
+	"aws/us-east-1":      {Compute: 1.00, Storage: 1.00, Egress: 1.00},
+	"aws/us-east-2":      {Compute: 1.00, Storage: 1.00, Egress: 1.00},
+	"aws/us-west-1":      {Compute: 1.17, Storage: 1.13, Egress: 1.00},
+	"aws/us-west-2":      {Compute: 1.00, Storage: 1.00, Egress: 1.00},
+	"aws/ca-central-1":   {Compute: 1.11, Storage: 1.09, Egress: 1.00},
+	"aws/ca-west-1":      {Compute: 1.11, Storage: 1.09, Egress: 1.00},
+	"aws/sa-east-1":      {Compute: 1.59, Storage: 1.76, Egress: 1.67},
+	"aws/eu-west-1":      {Compute: 1.11, Storage: 1.00, Egress: 1.00},
+	"aws/eu-west-2":      {Compute: 1.16, Storage: 1.04, Egress: 1.00},
+	"aws/eu-west-3":      {Compute: 1.17, Storage: 1.04, Egress: 1.00},
+	"aws/eu-central-1":   {Compute: 1.20, Storage: 1.07, Egress: 1.00},
+	"aws/eu-central-2":   {Compute: 1.32, Storage: 1.15, Egress: 1.00},
+	"aws/eu-north-1":     {Compute: 1.06, Storage: 1.00, Egress: 1.00},
+	"aws/eu-south-1":     {Compute: 1.17, Storage: 1.04, Egress: 1.00},
+	"aws/eu-south-2":     {Compute: 1.11, Storage: 1.00, Egress: 1.00},
+	"aws/il-central-1":   {Compute: 1.20, Storage: 1.09, Egress: 1.22},
+	"aws/me-south-1":     {Compute: 1.23, Storage: 1.08, Egress: 1.30},
+	"aws/me-central-1":   {Compute: 1.23, Storage: 1.08, Egress: 1.22},
+	"aws/af-south-1":     {Compute: 1.32, Storage: 1.19, Egress: 1.71},
+	"aws/ap-south-1":     {Compute: 1.05, Storage: 1.09, Egress: 1.21},
+	"aws/ap-south-2":     {Compute: 1.05, Storage: 1.09, Egress: 1.21},
+	"aws/ap-east-1":      {Compute: 1.38, Storage: 1.09, Egress: 1.33},
+	"aws/ap-southeast-1": {Compute: 1.25, Storage: 1.09, Egress: 1.33},
+	"aws/ap-southeast-2": {Compute: 1.25, Storage: 1.09, Egress: 1.27},
+	"aws/ap-southeast-3": {Compute: 1.25, Storage: 1.09, Egress: 1.47},
+	"aws/ap-southeast-4": {Compute: 1.25, Storage: 1.09, Egress: 1.27},
+	"aws/ap-southeast-5": {Compute: 1.18, Storage: 1.09, Egress: 1.33},
+	"aws/ap-northeast-1": {Compute: 1.29, Storage: 1.09, Egress: 1.27},
+	"aws/ap-northeast-2": {Compute: 1.23, Storage: 1.09, Egress: 1.40},
+	"aws/ap-northeast-3": {Compute: 1.29, Storage: 1.09, Egress: 1.27},
+})
@@ -0,0 +1,104 @@
+package where
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestBaselinePrices(t *testing.T) {
+	for id := range baselinePrices.prices {
+		if _, err := ByID(id); err != nil {
+			t.Errorf("baseline price for unknown region %v", id)
+		}
+	}
+
+	ohio, ok := MustIs("us-east-2").Price()
+	if !ok {
+		t.Fatal("us-east-2 should have a price index")
+	}
+	saoPaulo, _ := MustIs("sa-east-1").Price()
+	if saoPaulo.Compute <= ohio.Compute {
+		t.Errorf("sa-east-1 compute index %v should exceed us-east-2 %v", saoPaulo.Compute, ohio.Compute)
+	}
+}
+
+func TestMaxPriceIndex(t *testing.T) {
+	regions := NewQuery().MaxPriceIndex(1.2).SortByPrice().Exec()
+	if len(regions) == 0 {
+		t.Fatal("MaxPriceIndex() returned no regions")
+	}
+	prev := 0.0
+	for _, r := range regions {
+		p, ok := r.Price()
+		if !ok || p.Compute > 1.2 {
+			t.Errorf("MaxPriceIndex(1.2) returned %v with index %v", r.ID(), p.Compute)
+		}
+		if p.Compute < prev {
+			t.Error("SortByPrice() should sort cheapest first")
+		}
+		prev = p.Compute
+	}
+}
+
+func TestSortByPrice(t *testing.T) {
+	regions := Set{MustIs("sa-east-1"), MustIs("swedencentral"), MustIs("us-east-1")}
+	regions.SortByPrice()
+	if got := regions.Codes(); got[0] != "us-east-1" || got[2] != "swedencentral" {
+		t.Errorf("SortByPrice() = %v, want us-east-1 first and unpriced regions last", got)
+	}
+}
+
+func TestSortByDistanceAndPrice(t *testing.T) {
+	// From Rio de Janeiro, sa-east-1 is next door; us-east-1 is far but cheaper.
+	lat, lng := -22.9068, -43.1729
+	regions := Set{MustIs("us-east-1"), MustIs("sa-east-1")}
+
+	regions.SortByDistanceAndPrice(lat, lng, 1000)
+	if regions[0].Code != "sa-east-1" {
+		t.Errorf("with a low price weight, the nearest region should win, got %v", regions[0].Code)
+	}
+	regions.SortByDistanceAndPrice(lat, lng, 20000)
+	if regions[0].Code != "us-east-1" {
+		t.Errorf("with a high price weight, the cheaper region should win, got %v", regions[0].Code)
+	}
+}
+
+func TestUsePriceTable(t *testing.T) {
+	defer UsePriceTable(nil)
+
+	table, err := ReadPriceTable(strings.NewReader(`{"gcp/europe-west1": {"compute": 0.9, "storage": 1, "egress": 1.1}}`))
+	if err != nil {
+		t.Fatalf("ReadPriceTable() failed: %v", err)
+	}
+	UsePriceTable(table)
+	if got := NewQuery().MaxPriceIndex(1).Codes(); len(got) != 1 || got[0] != "europe-west1" {
+		t.Errorf("MaxPriceIndex() = %v, want [europe-west1]", got)
+	}
+	if _, ok := MustIs("us-east-1").Price(); ok {
+		t.Error("regions missing from the installed table should have no price")
+	}
+
+	data, err := json.Marshal(CurrentPriceTable())
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded PriceTable
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if p, ok := decoded.Price("gcp/europe-west1"); !ok || p.Compute != 0.9 {
+		t.Errorf("decoded Price(gcp/europe-west1) = %v, %v, want compute 0.9", p, ok)
+	}
+
+	UsePriceTable(nil)
+	if _, ok := MustIs("us-east-1").Price(); !ok {
+		t.Error("UsePriceTable(nil) should restore the baseline")
+	}
+
+	for _, data := range []string{`{"us-east-1": {"compute": 1}}`, `{"aws/us-east-1": {"compute": -1}}`, `[]`} {
+		if _, err := ReadPriceTable(strings.NewReader(data)); err == nil {
+			t.Errorf("ReadPriceTable(%s) should fail", data)
+		}
+	}
+}
@@ -52,6 +52,9 @@ var AWS = struct {
 	// Government (US)
 	USGovEast1 Code // us-gov-east-1
 	USGovWest1 Code // us-gov-west-1
+
+	// European Sovereign Cloud
+	EUSCDEEast1 Code // eusc-de-east-1 (Brandenburg)
 }{
 	// Initialize with actual region codes
 	USEast1:      "us-east-1",
@@ -88,6 +91,7 @@ var AWS = struct {
 	CNNorthwest1: "cn-northwest-1",
 	USGovEast1:   "us-gov-east-1",
 	USGovWest1:   "us-gov-west-1",
+	EUSCDEEast1:  "eusc-de-east-1",
 }
 
 // Azure provides direct access to all Microsoft Azure regions.
@@ -157,6 +161,21 @@ var Azure = struct {
 
 	// Oceania
 	NewZealandNorth Code // newzealandnorth
+
+	// Azure Government
+	USGovVirginia Code // usgovvirginia
+	USGovTexas    Code // usgovtexas
+	USGovArizona  Code // usgovarizona
+	USDoDEast     Code // usdodeast
+	USDoDCentral  Code // usdodcentral
+
+	// Azure China
+	ChinaEast   Code // chinaeast
+	ChinaNorth  Code // chinanorth
+	ChinaEast2  Code // chinaeast2
+	ChinaNorth2 Code // chinanorth2
+	ChinaEast3  Code // chinaeast3
+	ChinaNorth3 Code // chinanorth3
 }{
 	EastUS:             "eastus",
 	EastUS2:            "eastus2",
@@ -210,6 +229,17 @@ var Azure = struct {
 	ChileCentral:       "chilecentral",
 	MexicoCentral:      "mexicocentral",
 	NewZealandNorth:    "newzealandnorth",
+	USGovVirginia:      "usgovvirginia",
+	USGovTexas:         "usgovtexas",
+	USGovArizona:       "usgovarizona",
+	USDoDEast:          "usdodeast",
+	USDoDCentral:       "usdodcentral",
+	ChinaEast:          "chinaeast",
+	ChinaNorth:         "chinanorth",
+	ChinaEast2:         "chinaeast2",
+	ChinaNorth2:        "chinanorth2",
+	ChinaEast3:         "chinaeast3",
+	ChinaNorth3:        "chinanorth3",
 }
 
 // GCP provides direct access to all Google Cloud Platform regions.
@@ -267,6 +297,9 @@ var GCP = struct {
 
 	// Africa
 	AfricaSouth1 Code // africa-south1 (Johannesburg)
+
+	// Sovereign (partner-operated)
+	UFranceEast1 Code // u-france-east1 (S3NS, Paris)
 }{
 	USCentral1:             "us-central1",
 	USEast1:                "us-east1",
@@ -308,6 +341,7 @@ var GCP = struct {
 	MECentral2:             "me-central2",
 	MEWest1:                "me-west1",
 	AfricaSouth1:           "africa-south1",
+	UFranceEast1:           "u-france-east1",
 }
 
 // Yandex provides direct access to all Yandex Cloud regions.
@@ -392,3 +426,368 @@ var Alibaba = struct {
 	MECentral1:    "me-central-1",
 	NASouth1:      "na-south-1",
 }
+
+// DigitalOcean provides direct access to all DigitalOcean regions.
+var DigitalOcean = struct {
+	// North America
+	NYC1 Code // nyc1 (New York)
+	NYC2 Code // nyc2 (New York, legacy)
+	NYC3 Code // nyc3 (New York)
+	SFO1 Code // sfo1 (San Francisco, legacy)
+	SFO2 Code // sfo2 (San Francisco)
+	SFO3 Code // sfo3 (San Francisco)
+	TOR1 Code // tor1 (Toronto)
+	ATL1 Code // atl1 (Atlanta)
+
+	// Europe
+	AMS2 Code // ams2 (Amsterdam, legacy)
+	AMS3 Code // ams3 (Amsterdam)
+	LON1 Code // lon1 (London)
+	FRA1 Code // fra1 (Frankfurt)
+
+	// Asia Pacific
+	SGP1 Code // sgp1 (Singapore)
+	BLR1 Code // blr1 (Bangalore)
+	SYD1 Code // syd1 (Sydney)
+}{
+	NYC1: "nyc1",
+	NYC2: "nyc2",
+	NYC3: "nyc3",
+	SFO1: "sfo1",
+	SFO2: "sfo2",
+	SFO3: "sfo3",
+	TOR1: "tor1",
+	ATL1: "atl1",
+	AMS2: "ams2",
+	AMS3: "ams3",
+	LON1: "lon1",
+	FRA1: "fra1",
+	SGP1: "sgp1",
+	BLR1: "blr1",
+	SYD1: "syd1",
+}
+
+// Linode provides direct access to all Akamai Cloud (Linode) regions.
+var Linode = struct {
+	// North America
+	USEast      Code // us-east (Newark)
+	USCentral   Code // us-central (Dallas)
+	USWest      Code // us-west (Fremont)
+	USSoutheast Code // us-southeast (Atlanta)
+	USIAD       Code // us-iad (Washington, DC)
+	USORD       Code // us-ord (Chicago)
+	USSEA       Code // us-sea (Seattle)
+	USLAX       Code // us-lax (Los Angeles)
+	USMIA       Code // us-mia (Miami)
+	CACentral   Code // ca-central (Toronto)
+
+	// South America
+	BRGRU Code // br-gru (São Paulo)
+
+	// Europe
+	EUWest    Code // eu-west (London)
+	EUCentral Code // eu-central (Frankfurt)
+	FRPAR     Code // fr-par (Paris)
+	NLAMS     Code // nl-ams (Amsterdam)
+	SESTO     Code // se-sto (Stockholm)
+	ESMAD     Code // es-mad (Madrid)
+	ITMIL     Code // it-mil (Milan)
+
+	// Asia Pacific
+	APSouth     Code // ap-south (Singapore)
+	APNortheast Code // ap-northeast (Tokyo)
+	APWest      Code // ap-west (Mumbai)
+	APSoutheast Code // ap-southeast (Sydney)
+	INMAA       Code // in-maa (Chennai)
+	JPOSA       Code // jp-osa (Osaka)
+	IDCGK       Code // id-cgk (Jakarta)
+}{
+	USEast:      "us-east",
+	USCentral:   "us-central",
+	USWest:      "us-west",
+	USSoutheast: "us-southeast",
+	USIAD:       "us-iad",
+	USORD:       "us-ord",
+	USSEA:       "us-sea",
+	USLAX:       "us-lax",
+	USMIA:       "us-mia",
+	CACentral:   "ca-central",
+	BRGRU:       "br-gru",
+	EUWest:      "eu-west",
+	EUCentral:   "eu-central",
+	FRPAR:       "fr-par",
+	NLAMS:       "nl-ams",
+	SESTO:       "se-sto",
+	ESMAD:       "es-mad",
+	ITMIL:       "it-mil",
+	APSouth:     "ap-south",
+	APNortheast: "ap-northeast",
+	APWest:      "ap-west",
+	APSoutheast: "ap-southeast",
+	INMAA:       "in-maa",
+	JPOSA:       "jp-osa",
+	IDCGK:       "id-cgk",
+}
+
+// Vultr provides direct access to all Vultr regions.
+var Vultr = struct {
+	// North America
+	EWR Code // ewr (New Jersey)
+	ORD Code // ord (Chicago)
+	DFW Code // dfw (Dallas)
+	ATL Code // atl (Atlanta)
+	MIA Code // mia (Miami)
+	SEA Code // sea (Seattle)
+	SJC Code // sjc (Silicon Valley)
+	LAX Code // lax (Los Angeles)
+	HNL Code // hnl (Honolulu)
+	YTO Code // yto (Toronto)
+	MEX Code // mex (Mexico City)
+
+	// South America
+	SAO Code // sao (São Paulo)
+	SCL Code // scl (Santiago)
+
+	// Europe
+	AMS Code // ams (Amsterdam)
+	LHR Code // lhr (London)
+	MAN Code // man (Manchester)
+	FRA Code // fra (Frankfurt)
+	CDG Code // cdg (Paris)
+	WAW Code // waw (Warsaw)
+	MAD Code // mad (Madrid)
+	STO Code // sto (Stockholm)
+
+	// Asia Pacific
+	NRT Code // nrt (Tokyo)
+	OSA Code // osa (Osaka)
+	ICN Code // icn (Seoul)
+	SGP Code // sgp (Singapore)
+	BOM Code // bom (Mumbai)
+	DEL Code // del (Delhi NCR)
+	BLR Code // blr (Bangalore)
+	SYD Code // syd (Sydney)
+	MEL Code // mel (Melbourne)
+
+	// Middle East & Africa
+	TLV Code // tlv (Tel Aviv)
+	JNB Code // jnb (Johannesburg)
+}{
+	EWR: "ewr",
+	ORD: "ord",
+	DFW: "dfw",
+	ATL: "atl",
+	MIA: "mia",
+	SEA: "sea",
+	SJC: "sjc",
+	LAX: "lax",
+	HNL: "hnl",
+	YTO: "yto",
+	MEX: "mex",
+	SAO: "sao",
+	SCL: "scl",
+	AMS: "ams",
+	LHR: "lhr",
+	MAN: "man",
+	FRA: "fra",
+	CDG: "cdg",
+	WAW: "waw",
+	MAD: "mad",
+	STO: "sto",
+	NRT: "nrt",
+	OSA: "osa",
+	ICN: "icn",
+	SGP: "sgp",
+	BOM: "bom",
+	DEL: "del",
+	BLR: "blr",
+	SYD: "syd",
+	MEL: "mel",
+	TLV: "tlv",
+	JNB: "jnb",
+}
+
+// Fly provides direct access to all Fly.io regions.
+// Fly.io identifies regions by the nearest airport's IATA code.
+var Fly = struct {
+	// North America
+	IAD Code // iad (Ashburn)
+	EWR Code // ewr (Secaucus)
+	BOS Code // bos (Boston)
+	ATL Code // atl (Atlanta)
+	MIA Code // mia (Miami)
+	ORD Code // ord (Chicago)
+	DFW Code // dfw (Dallas)
+	DEN Code // den (Denver)
+	PHX Code // phx (Phoenix)
+	LAX Code // lax (Los Angeles)
+	SJC Code // sjc (San Jose)
+	SEA Code // sea (Seattle)
+	YUL Code // yul (Montreal)
+	YYZ Code // yyz (Toronto)
+	GDL Code // gdl (Guadalajara)
+	QRO Code // qro (Querétaro)
+
+	// South America
+	BOG Code // bog (Bogotá)
+	GRU Code // gru (São Paulo)
+	GIG Code // gig (Rio de Janeiro)
+	EZE Code // eze (Buenos Aires)
+	SCL Code // scl (Santiago)
+
+	// Europe
+	AMS Code // ams (Amsterdam)
+	ARN Code // arn (Stockholm)
+	CDG Code // cdg (Paris)
+	FRA Code // fra (Frankfurt)
+	LHR Code // lhr (London)
+	MAD Code // mad (Madrid)
+	OTP Code // otp (Bucharest)
+	WAW Code // waw (Warsaw)
+
+	// Asia Pacific
+	BOM Code // bom (Mumbai)
+	HKG Code // hkg (Hong Kong)
+	NRT Code // nrt (Tokyo)
+	SIN Code // sin (Singapore)
+	SYD Code // syd (Sydney)
+
+	// Africa
+	JNB Code // jnb (Johannesburg)
+}{
+	IAD: "iad",
+	EWR: "ewr",
+	BOS: "bos",
+	ATL: "atl",
+	MIA: "mia",
+	ORD: "ord",
+	DFW: "dfw",
+	DEN: "den",
+	PHX: "phx",
+	LAX: "lax",
+	SJC: "sjc",
+	SEA: "sea",
+	YUL: "yul",
+	YYZ: "yyz",
+	GDL: "gdl",
+	QRO: "qro",
+	BOG: "bog",
+	GRU: "gru",
+	GIG: "gig",
+	EZE: "eze",
+	SCL: "scl",
+	AMS: "ams",
+	ARN: "arn",
+	CDG: "cdg",
+	FRA: "fra",
+	LHR: "lhr",
+	MAD: "mad",
+	OTP: "otp",
+	WAW: "waw",
+	BOM: "bom",
+	HKG: "hkg",
+	NRT: "nrt",
+	SIN: "sin",
+	SYD: "syd",
+	JNB: "jnb",
+}
+
+// Tencent provides direct access to all Tencent Cloud regions.
+var Tencent = struct {
+	// China
+	APGuangzhou Code // ap-guangzhou
+	APShanghai  Code // ap-shanghai
+	APNanjing   Code // ap-nanjing
+	APBeijing   Code // ap-beijing
+	APChengdu   Code // ap-chengdu
+	APChongqing Code // ap-chongqing
+	APHongkong  Code // ap-hongkong
+
+	// Asia Pacific
+	APSingapore Code // ap-singapore
+	APJakarta   Code // ap-jakarta
+	APBangkok   Code // ap-bangkok
+	APSeoul     Code // ap-seoul
+	APTokyo     Code // ap-tokyo
+	APMumbai    Code // ap-mumbai
+
+	// Americas
+	NASiliconValley Code // na-siliconvalley
+	NAAshburn       Code // na-ashburn
+	SASaoPaulo      Code // sa-saopaulo
+
+	// Europe
+	EUFrankfurt Code // eu-frankfurt
+}{
+	APGuangzhou:     "ap-guangzhou",
+	APShanghai:      "ap-shanghai",
+	APNanjing:       "ap-nanjing",
+	APBeijing:       "ap-beijing",
+	APChengdu:       "ap-chengdu",
+	APChongqing:     "ap-chongqing",
+	APHongkong:      "ap-hongkong",
+	APSingapore:     "ap-singapore",
+	APJakarta:       "ap-jakarta",
+	APBangkok:       "ap-bangkok",
+	APSeoul:         "ap-seoul",
+	APTokyo:         "ap-tokyo",
+	APMumbai:        "ap-mumbai",
+	NASiliconValley: "na-siliconvalley",
+	NAAshburn:       "na-ashburn",
+	SASaoPaulo:      "sa-saopaulo",
+	EUFrankfurt:     "eu-frankfurt",
+}
+
+// Huawei provides direct access to all Huawei Cloud regions.
+// Several codes (ap-southeast-1, af-south-1, cn-north-1, me-east-1) are shared
+// with other providers but refer to different locations.
+var Huawei = struct {
+	// China
+	CNNorth1     Code // cn-north-1 (Beijing1)
+	CNNorth4     Code // cn-north-4 (Beijing4)
+	CNNorth9     Code // cn-north-9 (Ulanqab1)
+	CNEast2      Code // cn-east-2 (Shanghai2)
+	CNEast3      Code // cn-east-3 (Shanghai1)
+	CNSouth1     Code // cn-south-1 (Guangzhou)
+	CNSouthwest2 Code // cn-southwest-2 (Guiyang1)
+
+	// Asia Pacific
+	APSoutheast1 Code // ap-southeast-1 (Hong Kong)
+	APSoutheast2 Code // ap-southeast-2 (Bangkok)
+	APSoutheast3 Code // ap-southeast-3 (Singapore)
+	APSoutheast4 Code // ap-southeast-4 (Jakarta)
+
+	// Middle East, Europe & Africa
+	MEEast1   Code // me-east-1 (Riyadh)
+	TRWest1   Code // tr-west-1 (Istanbul)
+	EUWest0   Code // eu-west-0 (Paris)
+	EUWest101 Code // eu-west-101 (Dublin)
+	AFSouth1  Code // af-south-1 (Johannesburg)
+
+	// Latin America
+	NAMexico1 Code // na-mexico-1 (Mexico City1)
+	LANorth2  Code // la-north-2 (Mexico City2)
+	SABrazil1 Code // sa-brazil-1 (São Paulo1)
+	LASouth2  Code // la-south-2 (Santiago)
+}{
+	CNNorth1:     "cn-north-1",
+	CNNorth4:     "cn-north-4",
+	CNNorth9:     "cn-north-9",
+	CNEast2:      "cn-east-2",
+	CNEast3:      "cn-east-3",
+	CNSouth1:     "cn-south-1",
+	CNSouthwest2: "cn-southwest-2",
+	APSoutheast1: "ap-southeast-1",
+	APSoutheast2: "ap-southeast-2",
+	APSoutheast3: "ap-southeast-3",
+	APSoutheast4: "ap-southeast-4",
+	MEEast1:      "me-east-1",
+	TRWest1:      "tr-west-1",
+	EUWest0:      "eu-west-0",
+	EUWest101:    "eu-west-101",
+	AFSouth1:     "af-south-1",
+	NAMexico1:    "na-mexico-1",
+	LANorth2:     "la-north-2",
+	SABrazil1:    "sa-brazil-1",
+	LASouth2:     "la-south-2",
+}
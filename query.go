@@ -1,5 +1,7 @@
 package where
 
+import "time"
+
 // Query provides a fluent builder pattern for complex region queries.
 // Usage:
 //
@@ -13,7 +15,8 @@ type Query struct {
 	errors  []error
 }
 
-// NewQuery creates a new query builder starting with all regions.
+// NewQuery creates a new query builder starting with all regions, including
+// those in isolated partitions. Use CommercialOnly or InPartition to narrow it.
 func NewQuery() *Query {
 	return &Query{
 		regions: allRegions(),
@@ -102,6 +105,48 @@ func (q *Query) OnAlibaba() *Query {
 	return q.OnProvider("alibaba")
 }
 
+// OnDigitalOcean filters to only DigitalOcean regions.
+func (q *Query) OnDigitalOcean() *Query {
+	return q.OnProvider("digitalocean")
+}
+
+// OnLinode filters to only Akamai Cloud (Linode) regions.
+func (q *Query) OnLinode() *Query {
+	return q.OnProvider("linode")
+}
+
+// OnVultr filters to only Vultr regions.
+func (q *Query) OnVultr() *Query {
+	return q.OnProvider("vultr")
+}
+
+// OnFly filters to only Fly.io regions.
+func (q *Query) OnFly() *Query {
+	return q.OnProvider("fly")
+}
+
+// OnTencent filters to only Tencent Cloud regions.
+func (q *Query) OnTencent() *Query {
+	return q.OnProvider("tencent")
+}
+
+// OnHuawei filters to only Huawei Cloud regions.
+func (q *Query) OnHuawei() *Query {
+	return q.OnProvider("huawei")
+}
+
+// InPartition filters regions belonging to any of the given partitions.
+func (q *Query) InPartition(partitions ...Partition) *Query {
+	q.regions = q.regions.InPartition(partitions...)
+	return q
+}
+
+// CommercialOnly filters out regions from isolated partitions.
+func (q *Query) CommercialOnly() *Query {
+	q.regions = q.regions.CommercialOnly()
+	return q
+}
+
 // ActiveOnly filters to only active regions.
 func (q *Query) ActiveOnly() *Query {
 	q.regions = q.regions.ActiveOnly()
@@ -124,12 +169,84 @@ func (q *Query) DeprecatedOnly() *Query {
 	return q
 }
 
+// WithService filters regions of the provider that offer the service,
+// according to the current service matrix.
+func (q *Query) WithService(provider, service string) *Query {
+	q.regions = q.regions.WithService(provider, service)
+	return q
+}
+
+// OffersInstance filters regions offering the instance type, according to
+// the installed instance offerings.
+func (q *Query) OffersInstance(instanceType string) *Query {
+	q.regions = q.regions.OffersInstance(instanceType)
+	return q
+}
+
+// MaxPriceIndex filters regions whose compute price index is at most
+// maxIndex, according to the current price table.
+func (q *Query) MaxPriceIndex(maxIndex float64) *Query {
+	q.regions = q.regions.MaxPriceIndex(maxIndex)
+	return q
+}
+
+// MaxCarbonIntensity filters regions whose grid carbon intensity is at most
+// gramsPerKWh, according to the current carbon table.
+func (q *Query) MaxCarbonIntensity(gramsPerKWh float64) *Query {
+	q.regions = q.regions.MaxCarbonIntensity(gramsPerKWh)
+	return q
+}
+
+// AsOf filters regions available at time t and sets their Status as of t.
+func (q *Query) AsOf(t time.Time) *Query {
+	q.regions = q.regions.AsOf(t)
+	return q
+}
+
+// RetiringBetween filters regions whose retirement date falls in [from, to).
+func (q *Query) RetiringBetween(from, to time.Time) *Query {
+	q.regions = q.regions.RetiringBetween(from, to)
+	return q
+}
+
+// UsableBy filters regions that an account with the given capabilities can
+// use, dropping opt-in, restricted and isolated regions it has no access to.
+func (q *Query) UsableBy(c Capabilities) *Query {
+	q.regions = q.regions.UsableBy(c)
+	return q
+}
+
 // Near filters regions within the specified radius of a location.
 func (q *Query) Near(lat, lng float64, radiusKm float64) *Query {
 	q.regions = q.regions.Near(lat, lng, radiusKm)
 	return q
 }
 
+// WithinBBox filters regions inside a bounding box; see Set.WithinBBox.
+func (q *Query) WithinBBox(minLat, minLng, maxLat, maxLng float64) *Query {
+	q.regions = q.regions.WithinBBox(minLat, minLng, maxLat, maxLng)
+	return q
+}
+
+// WithinPolygon filters regions inside a shape of one or more rings; see
+// Set.WithinPolygon.
+func (q *Query) WithinPolygon(rings ...Polygon) *Query {
+	q.regions = q.regions.WithinPolygon(rings...)
+	return q
+}
+
+// WithinArea filters regions inside a built-in named area such as "eu",
+// "nordics" or "gcc"; see Area.
+func (q *Query) WithinArea(name string) *Query {
+	regions, err := q.regions.WithinArea(name)
+	if err != nil {
+		q.errors = append(q.errors, err)
+		return q
+	}
+	q.regions = regions
+	return q
+}
+
 // NearRegion filters regions within the specified radius of another region.
 func (q *Query) NearRegion(code Code, radiusKm float64) *Query {
 	query := Is(code)
@@ -141,17 +258,17 @@ func (q *Query) NearRegion(code Code, radiusKm float64) *Query {
 	return q.Near(region.Latitude, region.Longitude, radiusKm)
 }
 
-// NearCity filters regions within the specified radius of a city.
+// NearCity filters regions within the specified radius of a city. The city
+// is a region city of the catalog or any city of the embedded world-cities
+// dataset; see FindCity.
 func (q *Query) NearCity(cityName string, radiusKm float64) *Query {
-	// Find regions in the city first
-	cityRegions := allRegions().ByCity(cityName)
-	if len(cityRegions) == 0 {
-		q.regions = Set{} // No regions found in city
+	lat, lng, err := locateCity(cityName)
+	if err != nil {
+		q.errors = append(q.errors, err)
+		q.regions = Set{}
 		return q
 	}
-	// Use the first city region as reference point
-	ref := cityRegions[0]
-	return q.Near(ref.Latitude, ref.Longitude, radiusKm)
+	return q.Near(lat, lng, radiusKm)
 }
 
 // Filter applies a custom predicate function.
@@ -184,6 +301,25 @@ func (q *Query) SortByCountry() *Query {
 	return q
 }
 
+// SortByPrice sorts regions by compute price index (cheapest first).
+func (q *Query) SortByPrice() *Query {
+	q.regions.SortByPrice()
+	return q
+}
+
+// SortByDistanceAndPrice sorts regions by combined distance and price; see
+// Set.SortByDistanceAndPrice.
+func (q *Query) SortByDistanceAndPrice(lat, lng float64, kmPerIndex float64) *Query {
+	q.regions.SortByDistanceAndPrice(lat, lng, kmPerIndex)
+	return q
+}
+
+// SortByCarbon sorts regions by grid carbon intensity (lowest first).
+func (q *Query) SortByCarbon() *Query {
+	q.regions.SortByCarbon()
+	return q
+}
+
 // Limit restricts the result set to the first N regions.
 func (q *Query) Limit(n int) *Query {
 	if n < len(q.regions) {
@@ -8,36 +8,37 @@ var regions = []Region{
 	{Provider: "aws", Code: "us-east-2", Name: "US East (Ohio)", Country: "United States", City: "Columbus", Continent: "North America", Latitude: 39.9612, Longitude: -82.9988, Status: Active, LaunchDate: time.Date(2016, 10, 17, 0, 0, 0, 0, time.UTC), Zones: []string{"us-east-2a", "us-east-2b", "us-east-2c"}},
 	{Provider: "aws", Code: "us-west-1", Name: "US West (N. California)", Country: "United States", City: "San Francisco", Continent: "North America", Latitude: 37.7749, Longitude: -122.4194, Status: Active, LaunchDate: time.Date(2009, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"us-west-1a", "us-west-1b", "us-west-1c"}},
 	{Provider: "aws", Code: "us-west-2", Name: "US West (Oregon)", Country: "United States", City: "Portland", Continent: "North America", Latitude: 45.5152, Longitude: -122.6784, Status: Active, LaunchDate: time.Date(2011, 11, 8, 0, 0, 0, 0, time.UTC), Zones: []string{"us-west-2a", "us-west-2b", "us-west-2c", "us-west-2d"}},
-	{Provider: "aws", Code: "us-gov-east-1", Name: "AWS GovCloud (US-East)", Country: "United States", City: "Virginia", Continent: "North America", Latitude: 38.9047, Longitude: -77.0164, Status: Active, LaunchDate: time.Date(2018, 11, 12, 0, 0, 0, 0, time.UTC), Zones: []string{"us-gov-east-1a", "us-gov-east-1b", "us-gov-east-1c"}},
-	{Provider: "aws", Code: "us-gov-west-1", Name: "AWS GovCloud (US-West)", Country: "United States", City: "Oregon", Continent: "North America", Latitude: 45.5152, Longitude: -122.6784, Status: Active, LaunchDate: time.Date(2011, 8, 16, 0, 0, 0, 0, time.UTC), Zones: []string{"us-gov-west-1a", "us-gov-west-1b", "us-gov-west-1c"}},
+	{Provider: "aws", Code: "us-gov-east-1", Name: "AWS GovCloud (US-East)", Country: "United States", City: "Virginia", Continent: "North America", Latitude: 38.9047, Longitude: -77.0164, Status: Active, LaunchDate: time.Date(2018, 11, 12, 0, 0, 0, 0, time.UTC), Zones: []string{"us-gov-east-1a", "us-gov-east-1b", "us-gov-east-1c"}, Partition: PartitionAWSGovCloud},
+	{Provider: "aws", Code: "us-gov-west-1", Name: "AWS GovCloud (US-West)", Country: "United States", City: "Oregon", Continent: "North America", Latitude: 45.5152, Longitude: -122.6784, Status: Active, LaunchDate: time.Date(2011, 8, 16, 0, 0, 0, 0, time.UTC), Zones: []string{"us-gov-west-1a", "us-gov-west-1b", "us-gov-west-1c"}, Partition: PartitionAWSGovCloud},
 	{Provider: "aws", Code: "ca-central-1", Name: "Canada (Central)", Country: "Canada", City: "Toronto", Continent: "North America", Latitude: 43.6532, Longitude: -79.3832, Status: Active, LaunchDate: time.Date(2016, 12, 8, 0, 0, 0, 0, time.UTC), Zones: []string{"ca-central-1a", "ca-central-1b", "ca-central-1c"}},
-	{Provider: "aws", Code: "ca-west-1", Name: "Canada West (Calgary)", Country: "Canada", City: "Calgary", Continent: "North America", Latitude: 51.0447, Longitude: -114.0719, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ca-west-1a", "ca-west-1b", "ca-west-1c"}},
+	{Provider: "aws", Code: "ca-west-1", Name: "Canada West (Calgary)", Country: "Canada", City: "Calgary", Continent: "North America", Latitude: 51.0447, Longitude: -114.0719, Status: OptIn, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ca-west-1a", "ca-west-1b", "ca-west-1c"}},
 	{Provider: "aws", Code: "sa-east-1", Name: "South America (São Paulo)", Country: "Brazil", City: "São Paulo", Continent: "South America", Latitude: -23.5505, Longitude: -46.6333, Status: Active, LaunchDate: time.Date(2011, 12, 14, 0, 0, 0, 0, time.UTC), Zones: []string{"sa-east-1a", "sa-east-1b", "sa-east-1c"}},
 	{Provider: "aws", Code: "eu-central-1", Name: "Europe (Frankfurt)", Country: "Germany", City: "Frankfurt", Continent: "Europe", Latitude: 50.1109, Longitude: 8.6821, Status: Active, LaunchDate: time.Date(2014, 10, 23, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}},
 	{Provider: "aws", Code: "eu-west-1", Name: "Europe (Ireland)", Country: "Ireland", City: "Dublin", Continent: "Europe", Latitude: 53.3498, Longitude: -6.2603, Status: Active, LaunchDate: time.Date(2007, 12, 10, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-west-1a", "eu-west-1b", "eu-west-1c"}},
 	{Provider: "aws", Code: "eu-west-2", Name: "Europe (London)", Country: "United Kingdom", City: "London", Continent: "Europe", Latitude: 51.5074, Longitude: -0.1278, Status: Active, LaunchDate: time.Date(2016, 12, 13, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-west-2a", "eu-west-2b", "eu-west-2c"}},
-	{Provider: "aws", Code: "eu-south-1", Name: "Europe (Milan)", Country: "Italy", City: "Milan", Continent: "Europe", Latitude: 45.4642, Longitude: 9.1900, Status: Active, LaunchDate: time.Date(2020, 4, 28, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-south-1a", "eu-south-1b", "eu-south-1c"}},
+	{Provider: "aws", Code: "eu-south-1", Name: "Europe (Milan)", Country: "Italy", City: "Milan", Continent: "Europe", Latitude: 45.4642, Longitude: 9.1900, Status: OptIn, LaunchDate: time.Date(2020, 4, 28, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-south-1a", "eu-south-1b", "eu-south-1c"}},
 	{Provider: "aws", Code: "eu-west-3", Name: "Europe (Paris)", Country: "France", City: "Paris", Continent: "Europe", Latitude: 48.8566, Longitude: 2.3522, Status: Active, LaunchDate: time.Date(2017, 12, 18, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-west-3a", "eu-west-3b", "eu-west-3c"}},
-	{Provider: "aws", Code: "eu-south-2", Name: "Europe (Spain)", Country: "Spain", City: "Madrid", Continent: "Europe", Latitude: 40.4168, Longitude: -3.7038, Status: Active, LaunchDate: time.Date(2022, 11, 17, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-south-2a", "eu-south-2b", "eu-south-2c"}},
+	{Provider: "aws", Code: "eu-south-2", Name: "Europe (Spain)", Country: "Spain", City: "Madrid", Continent: "Europe", Latitude: 40.4168, Longitude: -3.7038, Status: OptIn, LaunchDate: time.Date(2022, 11, 17, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-south-2a", "eu-south-2b", "eu-south-2c"}},
 	{Provider: "aws", Code: "eu-north-1", Name: "Europe (Stockholm)", Country: "Sweden", City: "Stockholm", Continent: "Europe", Latitude: 59.3293, Longitude: 18.0686, Status: Active, LaunchDate: time.Date(2018, 12, 11, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-north-1a", "eu-north-1b", "eu-north-1c"}},
-	{Provider: "aws", Code: "eu-central-2", Name: "Europe (Zurich)", Country: "Switzerland", City: "Zurich", Continent: "Europe", Latitude: 47.3769, Longitude: 8.5417, Status: Active, LaunchDate: time.Date(2022, 8, 24, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-central-2a", "eu-central-2b", "eu-central-2c"}},
-	{Provider: "aws", Code: "af-south-1", Name: "Africa (Cape Town)", Country: "South Africa", City: "Cape Town", Continent: "Africa", Latitude: -33.9249, Longitude: 18.4241, Status: Active, LaunchDate: time.Date(2020, 4, 22, 0, 0, 0, 0, time.UTC), Zones: []string{"af-south-1a", "af-south-1b", "af-south-1c"}},
-	{Provider: "aws", Code: "ap-east-1", Name: "Asia Pacific (Hong Kong)", Country: "Hong Kong", City: "Hong Kong", Continent: "Asia", Latitude: 22.3193, Longitude: 114.1694, Status: Active, LaunchDate: time.Date(2019, 4, 24, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-east-1a", "ap-east-1b", "ap-east-1c"}},
-	{Provider: "aws", Code: "ap-south-2", Name: "Asia Pacific (Hyderabad)", Country: "India", City: "Hyderabad", Continent: "Asia", Latitude: 17.3850, Longitude: 78.4867, Status: Active, LaunchDate: time.Date(2022, 11, 22, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-south-2a", "ap-south-2b", "ap-south-2c"}},
-	{Provider: "aws", Code: "ap-southeast-3", Name: "Asia Pacific (Jakarta)", Country: "Indonesia", City: "Jakarta", Continent: "Asia", Latitude: -6.2088, Longitude: 106.8456, Status: Active, LaunchDate: time.Date(2021, 12, 13, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-3a", "ap-southeast-3b", "ap-southeast-3c"}},
-	{Provider: "aws", Code: "ap-southeast-5", Name: "Asia Pacific (Kuala Lumpur)", Country: "Malaysia", City: "Kuala Lumpur", Continent: "Asia", Latitude: 3.1390, Longitude: 101.6869, Status: Active, LaunchDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-5a", "ap-southeast-5b", "ap-southeast-5c"}},
+	{Provider: "aws", Code: "eu-central-2", Name: "Europe (Zurich)", Country: "Switzerland", City: "Zurich", Continent: "Europe", Latitude: 47.3769, Longitude: 8.5417, Status: OptIn, LaunchDate: time.Date(2022, 8, 24, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-central-2a", "eu-central-2b", "eu-central-2c"}},
+	{Provider: "aws", Code: "af-south-1", Name: "Africa (Cape Town)", Country: "South Africa", City: "Cape Town", Continent: "Africa", Latitude: -33.9249, Longitude: 18.4241, Status: OptIn, LaunchDate: time.Date(2020, 4, 22, 0, 0, 0, 0, time.UTC), Zones: []string{"af-south-1a", "af-south-1b", "af-south-1c"}},
+	{Provider: "aws", Code: "ap-east-1", Name: "Asia Pacific (Hong Kong)", Country: "Hong Kong", City: "Hong Kong", Continent: "Asia", Latitude: 22.3193, Longitude: 114.1694, Status: OptIn, LaunchDate: time.Date(2019, 4, 24, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-east-1a", "ap-east-1b", "ap-east-1c"}},
+	{Provider: "aws", Code: "ap-south-2", Name: "Asia Pacific (Hyderabad)", Country: "India", City: "Hyderabad", Continent: "Asia", Latitude: 17.3850, Longitude: 78.4867, Status: OptIn, LaunchDate: time.Date(2022, 11, 22, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-south-2a", "ap-south-2b", "ap-south-2c"}},
+	{Provider: "aws", Code: "ap-southeast-3", Name: "Asia Pacific (Jakarta)", Country: "Indonesia", City: "Jakarta", Continent: "Asia", Latitude: -6.2088, Longitude: 106.8456, Status: OptIn, LaunchDate: time.Date(2021, 12, 13, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-3a", "ap-southeast-3b", "ap-southeast-3c"}},
+	{Provider: "aws", Code: "ap-southeast-5", Name: "Asia Pacific (Kuala Lumpur)", Country: "Malaysia", City: "Kuala Lumpur", Continent: "Asia", Latitude: 3.1390, Longitude: 101.6869, Status: OptIn, LaunchDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-5a", "ap-southeast-5b", "ap-southeast-5c"}},
 	{Provider: "aws", Code: "ap-south-1", Name: "Asia Pacific (Mumbai)", Country: "India", City: "Mumbai", Continent: "Asia", Latitude: 19.0760, Longitude: 72.8777, Status: Active, LaunchDate: time.Date(2016, 6, 27, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-south-1a", "ap-south-1b", "ap-south-1c"}},
 	{Provider: "aws", Code: "ap-northeast-3", Name: "Asia Pacific (Osaka)", Country: "Japan", City: "Osaka", Continent: "Asia", Latitude: 34.6937, Longitude: 135.5023, Status: Active, LaunchDate: time.Date(2018, 2, 12, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-northeast-3a", "ap-northeast-3b", "ap-northeast-3c"}},
 	{Provider: "aws", Code: "ap-northeast-2", Name: "Asia Pacific (Seoul)", Country: "South Korea", City: "Seoul", Continent: "Asia", Latitude: 37.5665, Longitude: 126.9780, Status: Active, LaunchDate: time.Date(2016, 1, 6, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-northeast-2a", "ap-northeast-2b", "ap-northeast-2c", "ap-northeast-2d"}},
 	{Provider: "aws", Code: "ap-southeast-1", Name: "Asia Pacific (Singapore)", Country: "Singapore", City: "Singapore", Continent: "Asia", Latitude: 1.3521, Longitude: 103.8198, Status: Active, LaunchDate: time.Date(2010, 4, 28, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-1a", "ap-southeast-1b", "ap-southeast-1c"}},
 	{Provider: "aws", Code: "ap-northeast-1", Name: "Asia Pacific (Tokyo)", Country: "Japan", City: "Tokyo", Continent: "Asia", Latitude: 35.6762, Longitude: 139.6503, Status: Active, LaunchDate: time.Date(2011, 3, 2, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-northeast-1a", "ap-northeast-1b", "ap-northeast-1c", "ap-northeast-1d"}},
-	{Provider: "aws", Code: "il-central-1", Name: "Israel (Tel Aviv)", Country: "Israel", City: "Tel Aviv", Continent: "Asia", Latitude: 32.0853, Longitude: 34.7818, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"il-central-1a", "il-central-1b", "il-central-1c"}},
-	{Provider: "aws", Code: "me-south-1", Name: "Middle East (Bahrain)", Country: "Bahrain", City: "Manama", Continent: "Asia", Latitude: 26.0667, Longitude: 50.5577, Status: Active, LaunchDate: time.Date(2019, 7, 29, 0, 0, 0, 0, time.UTC), Zones: []string{"me-south-1a", "me-south-1b", "me-south-1c"}},
-	{Provider: "aws", Code: "me-central-1", Name: "Middle East (UAE)", Country: "United Arab Emirates", City: "Dubai", Continent: "Asia", Latitude: 25.2048, Longitude: 55.2708, Status: Active, LaunchDate: time.Date(2022, 8, 30, 0, 0, 0, 0, time.UTC), Zones: []string{"me-central-1a", "me-central-1b", "me-central-1c"}},
-	{Provider: "aws", Code: "cn-north-1", Name: "Mainland China (Beijing)", Country: "China", City: "Beijing", Continent: "Asia", Latitude: 39.9042, Longitude: 116.4074, Status: Active, LaunchDate: time.Date(2013, 12, 18, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-north-1a", "cn-north-1b", "cn-north-1c"}},
-	{Provider: "aws", Code: "cn-northwest-1", Name: "Mainland China (Ningxia)", Country: "China", City: "Ningxia", Continent: "Asia", Latitude: 38.4872, Longitude: 106.2309, Status: Active, LaunchDate: time.Date(2017, 12, 11, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-northwest-1a", "cn-northwest-1b", "cn-northwest-1c"}},
+	{Provider: "aws", Code: "il-central-1", Name: "Israel (Tel Aviv)", Country: "Israel", City: "Tel Aviv", Continent: "Asia", Latitude: 32.0853, Longitude: 34.7818, Status: OptIn, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"il-central-1a", "il-central-1b", "il-central-1c"}},
+	{Provider: "aws", Code: "me-south-1", Name: "Middle East (Bahrain)", Country: "Bahrain", City: "Manama", Continent: "Asia", Latitude: 26.0667, Longitude: 50.5577, Status: OptIn, LaunchDate: time.Date(2019, 7, 29, 0, 0, 0, 0, time.UTC), Zones: []string{"me-south-1a", "me-south-1b", "me-south-1c"}},
+	{Provider: "aws", Code: "me-central-1", Name: "Middle East (UAE)", Country: "United Arab Emirates", City: "Dubai", Continent: "Asia", Latitude: 25.2048, Longitude: 55.2708, Status: OptIn, LaunchDate: time.Date(2022, 8, 30, 0, 0, 0, 0, time.UTC), Zones: []string{"me-central-1a", "me-central-1b", "me-central-1c"}},
+	{Provider: "aws", Code: "cn-north-1", Name: "Mainland China (Beijing)", Country: "China", City: "Beijing", Continent: "Asia", Latitude: 39.9042, Longitude: 116.4074, Status: Active, LaunchDate: time.Date(2013, 12, 18, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-north-1a", "cn-north-1b", "cn-north-1c"}, Partition: PartitionAWSChina},
+	{Provider: "aws", Code: "cn-northwest-1", Name: "Mainland China (Ningxia)", Country: "China", City: "Ningxia", Continent: "Asia", Latitude: 38.4872, Longitude: 106.2309, Status: Active, LaunchDate: time.Date(2017, 12, 11, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-northwest-1a", "cn-northwest-1b", "cn-northwest-1c"}, Partition: PartitionAWSChina},
 	{Provider: "aws", Code: "ap-southeast-2", Name: "Asia Pacific (Sydney)", Country: "Australia", City: "Sydney", Continent: "Oceania", Latitude: -33.8688, Longitude: 151.2093, Status: Active, LaunchDate: time.Date(2012, 11, 13, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-2a", "ap-southeast-2b", "ap-southeast-2c"}},
-	{Provider: "aws", Code: "ap-southeast-4", Name: "Asia Pacific (Melbourne)", Country: "Australia", City: "Melbourne", Continent: "Oceania", Latitude: -37.8136, Longitude: 144.9631, Status: Active, LaunchDate: time.Date(2023, 1, 30, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-4a", "ap-southeast-4b", "ap-southeast-4c"}},
+	{Provider: "aws", Code: "ap-southeast-4", Name: "Asia Pacific (Melbourne)", Country: "Australia", City: "Melbourne", Continent: "Oceania", Latitude: -37.8136, Longitude: 144.9631, Status: OptIn, LaunchDate: time.Date(2023, 1, 30, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-4a", "ap-southeast-4b", "ap-southeast-4c"}},
+	{Provider: "aws", Code: "eusc-de-east-1", Name: "AWS European Sovereign Cloud (Brandenburg)", Country: "Germany", City: "Brandenburg", Continent: "Europe", Latitude: 52.4125, Longitude: 12.5316, Status: Active, LaunchDate: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"eusc-de-east-1a", "eusc-de-east-1b", "eusc-de-east-1c"}, Partition: PartitionAWSEuropeanSovereign},
 	// Azure Regions
 	{Provider: "azure", Code: "eastus", Name: "East US (Virginia)", Country: "United States", City: "Virginia", Continent: "North America", Latitude: 37.3719, Longitude: -79.8164, Status: Active, LaunchDate: time.Date(2012, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"eastus-1", "eastus-2", "eastus-3"}},
 	{Provider: "azure", Code: "eastus2", Name: "East US 2 (Virginia)", Country: "United States", City: "Virginia", Continent: "North America", Latitude: 36.6681, Longitude: -78.3889, Status: Active, LaunchDate: time.Date(2014, 4, 24, 0, 0, 0, 0, time.UTC), Zones: []string{"eastus2-1", "eastus2-2", "eastus2-3"}},
@@ -62,13 +63,13 @@ var regions = []Region{
 	{Provider: "azure", Code: "koreacentral", Name: "Korea Central (Seoul)", Country: "South Korea", City: "Seoul", Continent: "Asia", Latitude: 37.5665, Longitude: 126.9780, Status: Active, LaunchDate: time.Date(2017, 1, 31, 0, 0, 0, 0, time.UTC), Zones: []string{"koreacentral-1", "koreacentral-2", "koreacentral-3"}},
 	{Provider: "azure", Code: "koreasouth", Name: "Korea South (Busan)", Country: "South Korea", City: "Busan", Continent: "Asia", Latitude: 35.1796, Longitude: 129.0756, Status: Active, LaunchDate: time.Date(2017, 1, 31, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "francecentral", Name: "France Central (Paris)", Country: "France", City: "Paris", Continent: "Europe", Latitude: 46.3772, Longitude: 2.3730, Status: Active, LaunchDate: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"francecentral-1", "francecentral-2", "francecentral-3"}},
-	{Provider: "azure", Code: "francesouth", Name: "France South (Marseille)", Country: "France", City: "Marseille", Continent: "Europe", Latitude: 43.8345, Longitude: 2.1972, Status: Active, LaunchDate: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "azure", Code: "francesouth", Name: "France South (Marseille)", Country: "France", City: "Marseille", Continent: "Europe", Latitude: 43.8345, Longitude: 2.1972, Status: Restricted, LaunchDate: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "germanywestcentral", Name: "Germany West Central (Frankfurt)", Country: "Germany", City: "Frankfurt", Continent: "Europe", Latitude: 50.110924, Longitude: 8.682127, Status: Active, LaunchDate: time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"germanywestcentral-1", "germanywestcentral-2", "germanywestcentral-3"}},
-	{Provider: "azure", Code: "germanynorth", Name: "Germany North (Berlin)", Country: "Germany", City: "Berlin", Continent: "Europe", Latitude: 52.5200, Longitude: 13.4050, Status: Active, LaunchDate: time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "azure", Code: "germanynorth", Name: "Germany North (Berlin)", Country: "Germany", City: "Berlin", Continent: "Europe", Latitude: 52.5200, Longitude: 13.4050, Status: Restricted, LaunchDate: time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "norwayeast", Name: "Norway East (Oslo)", Country: "Norway", City: "Oslo", Continent: "Europe", Latitude: 59.913868, Longitude: 10.752245, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"norwayeast-1", "norwayeast-2", "norwayeast-3"}},
-	{Provider: "azure", Code: "norwaywest", Name: "Norway West (Stavanger)", Country: "Norway", City: "Stavanger", Continent: "Europe", Latitude: 58.9700, Longitude: 5.7331, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "azure", Code: "norwaywest", Name: "Norway West (Stavanger)", Country: "Norway", City: "Stavanger", Continent: "Europe", Latitude: 58.9700, Longitude: 5.7331, Status: Restricted, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "switzerlandnorth", Name: "Switzerland North (Zurich)", Country: "Switzerland", City: "Zurich", Continent: "Europe", Latitude: 47.451542, Longitude: 8.564572, Status: Active, LaunchDate: time.Date(2019, 8, 30, 0, 0, 0, 0, time.UTC), Zones: []string{"switzerlandnorth-1", "switzerlandnorth-2", "switzerlandnorth-3"}},
-	{Provider: "azure", Code: "switzerlandwest", Name: "Switzerland West (Geneva)", Country: "Switzerland", City: "Geneva", Continent: "Europe", Latitude: 46.2044, Longitude: 6.1432, Status: Active, LaunchDate: time.Date(2019, 8, 30, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "azure", Code: "switzerlandwest", Name: "Switzerland West (Geneva)", Country: "Switzerland", City: "Geneva", Continent: "Europe", Latitude: 46.2044, Longitude: 6.1432, Status: Restricted, LaunchDate: time.Date(2019, 8, 30, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "swedencentral", Name: "Sweden Central (Gävle)", Country: "Sweden", City: "Gävle", Continent: "Europe", Latitude: 60.6749, Longitude: 17.1413, Status: Active, LaunchDate: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"swedencentral-1", "swedencentral-2", "swedencentral-3"}},
 	{Provider: "azure", Code: "swedensouth", Name: "Sweden South (Malmö)", Country: "Sweden", City: "Malmö", Continent: "Europe", Latitude: 55.6050, Longitude: 13.0038, Status: Active, LaunchDate: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "uksouth", Name: "UK South (London)", Country: "United Kingdom", City: "London", Continent: "Europe", Latitude: 50.941, Longitude: -0.799, Status: Active, LaunchDate: time.Date(2016, 9, 7, 0, 0, 0, 0, time.UTC), Zones: []string{"uksouth-1", "uksouth-2", "uksouth-3"}},
@@ -78,17 +79,28 @@ var regions = []Region{
 	{Provider: "azure", Code: "italynorth", Name: "Italy North (Milan)", Country: "Italy", City: "Milan", Continent: "Europe", Latitude: 45.4642, Longitude: 9.1900, Status: Active, LaunchDate: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"italynorth-1", "italynorth-2", "italynorth-3"}},
 	{Provider: "azure", Code: "israelcentral", Name: "Israel Central (Jerusalem)", Country: "Israel", City: "Jerusalem", Continent: "Asia", Latitude: 31.7683, Longitude: 35.2137, Status: Active, LaunchDate: time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"israelcentral-1", "israelcentral-2", "israelcentral-3"}},
 	{Provider: "azure", Code: "uaenorth", Name: "UAE North (Dubai)", Country: "United Arab Emirates", City: "Dubai", Continent: "Asia", Latitude: 25.266, Longitude: 55.316, Status: Active, LaunchDate: time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC), Zones: []string{"uaenorth-1", "uaenorth-2", "uaenorth-3"}},
-	{Provider: "azure", Code: "uaecentral", Name: "UAE Central (Abu Dhabi)", Country: "United Arab Emirates", City: "Abu Dhabi", Continent: "Asia", Latitude: 24.4539, Longitude: 54.3773, Status: Active, LaunchDate: time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "azure", Code: "uaecentral", Name: "UAE Central (Abu Dhabi)", Country: "United Arab Emirates", City: "Abu Dhabi", Continent: "Asia", Latitude: 24.4539, Longitude: 54.3773, Status: Restricted, LaunchDate: time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "qatarcentral", Name: "Qatar Central (Doha)", Country: "Qatar", City: "Doha", Continent: "Asia", Latitude: 25.3548, Longitude: 51.1839, Status: Active, LaunchDate: time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"qatarcentral-1", "qatarcentral-2", "qatarcentral-3"}},
 	{Provider: "azure", Code: "southafricanorth", Name: "South Africa North (Johannesburg)", Country: "South Africa", City: "Johannesburg", Continent: "Africa", Latitude: -26.2041, Longitude: 28.0473, Status: Active, LaunchDate: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"southafricanorth-1", "southafricanorth-2", "southafricanorth-3"}},
-	{Provider: "azure", Code: "southafricawest", Name: "South Africa West (Cape Town)", Country: "South Africa", City: "Cape Town", Continent: "Africa", Latitude: -33.9249, Longitude: 18.4241, Status: Active, LaunchDate: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "azure", Code: "southafricawest", Name: "South Africa West (Cape Town)", Country: "South Africa", City: "Cape Town", Continent: "Africa", Latitude: -33.9249, Longitude: 18.4241, Status: Restricted, LaunchDate: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "australiacentral", Name: "Australia Central (Canberra)", Country: "Australia", City: "Canberra", Continent: "Oceania", Latitude: -35.2809, Longitude: 149.1300, Status: Active, LaunchDate: time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
-	{Provider: "azure", Code: "australiacentral2", Name: "Australia Central 2 (Canberra)", Country: "Australia", City: "Canberra", Continent: "Oceania", Latitude: -35.2809, Longitude: 149.1300, Status: Active, LaunchDate: time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "azure", Code: "australiacentral2", Name: "Australia Central 2 (Canberra)", Country: "Australia", City: "Canberra", Continent: "Oceania", Latitude: -35.2809, Longitude: 149.1300, Status: Restricted, LaunchDate: time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "austriaeast", Name: "Austria East (Vienna)", Country: "Austria", City: "Vienna", Continent: "Europe", Latitude: 48.2082, Longitude: 16.3738, Status: Active, LaunchDate: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"austriaeast-1", "austriaeast-2", "austriaeast-3"}},
-	{Provider: "azure", Code: "brazilsoutheast", Name: "Brazil Southeast (Rio)", Country: "Brazil", City: "Rio", Continent: "South America", Latitude: -22.9068, Longitude: -43.1729, Status: Active, LaunchDate: time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "azure", Code: "brazilsoutheast", Name: "Brazil Southeast (Rio)", Country: "Brazil", City: "Rio", Continent: "South America", Latitude: -22.9068, Longitude: -43.1729, Status: Restricted, LaunchDate: time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
 	{Provider: "azure", Code: "newzealandnorth", Name: "New Zealand North (Auckland)", Country: "New Zealand", City: "Auckland", Continent: "Oceania", Latitude: -36.8485, Longitude: 174.7633, Status: Active, LaunchDate: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"newzealandnorth-1", "newzealandnorth-2", "newzealandnorth-3"}},
 	{Provider: "azure", Code: "chilecentral", Name: "Chile Central (Santiago)", Country: "Chile", City: "Santiago", Continent: "South America", Latitude: -33.4489, Longitude: -70.6693, Status: Active, LaunchDate: time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"chilecentral-1", "chilecentral-2", "chilecentral-3"}},
 	{Provider: "azure", Code: "mexicocentral", Name: "Mexico Central (Querétaro)", Country: "Mexico", City: "Querétaro", Continent: "North America", Latitude: 20.5888, Longitude: -100.3899, Status: Active, LaunchDate: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"mexicocentral-1", "mexicocentral-2", "mexicocentral-3"}},
+	{Provider: "azure", Code: "usgovvirginia", Name: "US Gov Virginia", Country: "United States", City: "Virginia", Continent: "North America", Latitude: 37.3719, Longitude: -79.8164, Status: Active, LaunchDate: time.Date(2014, 12, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"usgovvirginia-1", "usgovvirginia-2", "usgovvirginia-3"}, Partition: PartitionAzureGovernment},
+	{Provider: "azure", Code: "usgovtexas", Name: "US Gov Texas", Country: "United States", City: "Texas", Continent: "North America", Latitude: 29.4241, Longitude: -98.4936, Status: Active, LaunchDate: time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}, Partition: PartitionAzureGovernment},
+	{Provider: "azure", Code: "usgovarizona", Name: "US Gov Arizona", Country: "United States", City: "Arizona", Continent: "North America", Latitude: 33.4484, Longitude: -112.074, Status: Active, LaunchDate: time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}, Partition: PartitionAzureGovernment},
+	{Provider: "azure", Code: "usdodeast", Name: "US DoD East", Country: "United States", City: "Virginia", Continent: "North America", Latitude: 36.6676, Longitude: -78.3875, Status: Active, LaunchDate: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}, Partition: PartitionAzureGovernment},
+	{Provider: "azure", Code: "usdodcentral", Name: "US DoD Central", Country: "United States", City: "Iowa", Continent: "North America", Latitude: 41.5868, Longitude: -93.625, Status: Active, LaunchDate: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}, Partition: PartitionAzureGovernment},
+	{Provider: "azure", Code: "chinaeast", Name: "China East (Shanghai)", Country: "China", City: "Shanghai", Continent: "Asia", Latitude: 31.2304, Longitude: 121.4737, Status: Retired, LaunchDate: time.Date(2014, 3, 26, 0, 0, 0, 0, time.UTC), Zones: []string{}, Partition: PartitionAzureChina, Lifecycle: Lifecycle{DeprecationNotice: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC), Retirement: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)}},
+	{Provider: "azure", Code: "chinanorth", Name: "China North (Beijing)", Country: "China", City: "Beijing", Continent: "Asia", Latitude: 39.9042, Longitude: 116.4074, Status: Retired, LaunchDate: time.Date(2014, 3, 26, 0, 0, 0, 0, time.UTC), Zones: []string{}, Partition: PartitionAzureChina, Lifecycle: Lifecycle{DeprecationNotice: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC), Retirement: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)}},
+	{Provider: "azure", Code: "chinaeast2", Name: "China East 2 (Shanghai)", Country: "China", City: "Shanghai", Continent: "Asia", Latitude: 31.2304, Longitude: 121.4737, Status: Active, LaunchDate: time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}, Partition: PartitionAzureChina},
+	{Provider: "azure", Code: "chinanorth2", Name: "China North 2 (Beijing)", Country: "China", City: "Beijing", Continent: "Asia", Latitude: 39.9042, Longitude: 116.4074, Status: Active, LaunchDate: time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}, Partition: PartitionAzureChina},
+	{Provider: "azure", Code: "chinaeast3", Name: "China East 3 (Shanghai)", Country: "China", City: "Shanghai", Continent: "Asia", Latitude: 31.2304, Longitude: 121.4737, Status: Active, LaunchDate: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"chinaeast3-1", "chinaeast3-2", "chinaeast3-3"}, Partition: PartitionAzureChina},
+	{Provider: "azure", Code: "chinanorth3", Name: "China North 3 (Hebei)", Country: "China", City: "Hebei", Continent: "Asia", Latitude: 38.0428, Longitude: 114.5149, Status: Active, LaunchDate: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"chinanorth3-1", "chinanorth3-2", "chinanorth3-3"}, Partition: PartitionAzureChina},
 	// GCP Regions
 	{Provider: "gcp", Code: "africa-south1", Name: "Johannesburg, South Africa", Country: "South Africa", City: "Johannesburg", Continent: "Africa", Latitude: -26.2041, Longitude: 28.0473, Status: Active, LaunchDate: time.Date(2022, 10, 5, 0, 0, 0, 0, time.UTC), Zones: []string{"africa-south1-a", "africa-south1-b", "africa-south1-c"}},
 	{Provider: "gcp", Code: "asia-east1", Name: "Changhua County, Taiwan", Country: "Taiwan", City: "Changhua County", Continent: "Asia", Latitude: 24.0717, Longitude: 120.5624, Status: Active, LaunchDate: time.Date(2013, 12, 11, 0, 0, 0, 0, time.UTC), Zones: []string{"asia-east1-a", "asia-east1-b", "asia-east1-c"}},
@@ -128,6 +140,7 @@ var regions = []Region{
 	{Provider: "gcp", Code: "us-west2", Name: "California, USA (Los Angeles)", Country: "United States", City: "Los Angeles", Continent: "North America", Latitude: 34.0522, Longitude: -118.2437, Status: Active, LaunchDate: time.Date(2018, 2, 6, 0, 0, 0, 0, time.UTC), Zones: []string{"us-west2-a", "us-west2-b", "us-west2-c"}},
 	{Provider: "gcp", Code: "us-west3", Name: "Utah, USA (Salt Lake City)", Country: "United States", City: "Salt Lake City", Continent: "North America", Latitude: 40.7608, Longitude: -111.8910, Status: Active, LaunchDate: time.Date(2020, 9, 23, 0, 0, 0, 0, time.UTC), Zones: []string{"us-west3-a", "us-west3-b", "us-west3-c"}},
 	{Provider: "gcp", Code: "us-west4", Name: "Nevada, USA (Las Vegas)", Country: "United States", City: "Las Vegas", Continent: "North America", Latitude: 36.1699, Longitude: -115.1398, Status: Active, LaunchDate: time.Date(2020, 2, 13, 0, 0, 0, 0, time.UTC), Zones: []string{"us-west4-a", "us-west4-b", "us-west4-c"}},
+	{Provider: "gcp", Code: "u-france-east1", Name: "S3NS France (Paris)", Country: "France", City: "Paris", Continent: "Europe", Latitude: 48.8566, Longitude: 2.3522, Status: Active, LaunchDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"u-france-east1-a", "u-france-east1-b", "u-france-east1-c"}, Partition: PartitionGCPSovereign},
 	// Alibaba Cloud Regions
 	{Provider: "alibaba", Code: "cn-qingdao", Name: "China (Qingdao)", Country: "China", City: "Qingdao", Continent: "Asia", Latitude: 36.0986, Longitude: 120.3719, Status: Active, LaunchDate: time.Date(2012, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-qingdao-b", "cn-qingdao-c"}},
 	{Provider: "alibaba", Code: "cn-beijing", Name: "China (Beijing)", Country: "China", City: "Beijing", Continent: "Asia", Latitude: 39.9042, Longitude: 116.4074, Status: Active, LaunchDate: time.Date(2012, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-beijing-a", "cn-beijing-b", "cn-beijing-c", "cn-beijing-d", "cn-beijing-e", "cn-beijing-f", "cn-beijing-g", "cn-beijing-h", "cn-beijing-i", "cn-beijing-j", "cn-beijing-k", "cn-beijing-l"}},
@@ -156,16 +169,181 @@ var regions = []Region{
 	{Provider: "alibaba", Code: "eu-central-1", Name: "Germany (Frankfurt)", Country: "Germany", City: "Frankfurt", Continent: "Europe", Latitude: 50.1109, Longitude: 8.6821, Status: Active, LaunchDate: time.Date(2016, 12, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}},
 	{Provider: "alibaba", Code: "eu-west-1", Name: "UK (London)", Country: "United Kingdom", City: "London", Continent: "Europe", Latitude: 51.5074, Longitude: -0.1278, Status: Active, LaunchDate: time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-west-1a", "eu-west-1b"}},
 	{Provider: "alibaba", Code: "me-east-1", Name: "UAE (Dubai)", Country: "United Arab Emirates", City: "Dubai", Continent: "Asia", Latitude: 25.2048, Longitude: 55.2708, Status: Active, LaunchDate: time.Date(2018, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"me-east-1a"}},
-	{Provider: "alibaba", Code: "me-central-1", Name: "Saudi Arabia (Riyadh - Partner)", Country: "Saudi Arabia", City: "Riyadh", Continent: "Asia", Latitude: 24.7136, Longitude: 46.6753, Status: Active, LaunchDate: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"me-central-1a", "me-central-1b"}},
+	{Provider: "alibaba", Code: "me-central-1", Name: "Saudi Arabia (Riyadh - Partner)", Country: "Saudi Arabia", City: "Riyadh", Continent: "Asia", Latitude: 24.7136, Longitude: 46.6753, Status: Restricted, LaunchDate: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"me-central-1a", "me-central-1b"}},
 	{Provider: "alibaba", Code: "na-south-1", Name: "Mexico", Country: "Mexico", City: "Mexico City", Continent: "North America", Latitude: 19.4326, Longitude: -99.1332, Status: Active, LaunchDate: time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"na-south-1a"}},
 	// Yandex Cloud Regions
 	{Provider: "yandex", Code: "ru-central1", Name: "Russia (Central)", Country: "Russia", City: "Moscow", Continent: "Europe", Latitude: 55.7558, Longitude: 37.6176, Status: Active, LaunchDate: time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ru-central1-a", "ru-central1-b", "ru-central1-d"}},
 	{Provider: "yandex", Code: "kz1", Name: "Kazakhstan", Country: "Kazakhstan", City: "Almaty", Continent: "Asia", Latitude: 43.2775, Longitude: 76.8958, Status: Active, LaunchDate: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"kz1-a"}},
+	// DigitalOcean Regions
+	{Provider: "digitalocean", Code: "nyc1", Name: "New York 1", Country: "United States", City: "New York", Continent: "North America", Latitude: 40.7128, Longitude: -74.006, Status: Active, LaunchDate: time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "nyc3", Name: "New York 3", Country: "United States", City: "New York", Continent: "North America", Latitude: 40.7128, Longitude: -74.006, Status: Active, LaunchDate: time.Date(2014, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	// Legacy DigitalOcean regions: the regions API lists them as unavailable,
+	// so existing resources keep running but new ones cannot be created.
+	// DigitalOcean published no deprecation dates for them.
+	{Provider: "digitalocean", Code: "nyc2", Name: "New York 2", Country: "United States", City: "New York", Continent: "North America", Latitude: 40.7128, Longitude: -74.006, Status: Deprecated, LaunchDate: time.Date(2013, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "sfo1", Name: "San Francisco 1", Country: "United States", City: "San Francisco", Continent: "North America", Latitude: 37.7749, Longitude: -122.4194, Status: Deprecated, LaunchDate: time.Date(2013, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "sfo2", Name: "San Francisco 2", Country: "United States", City: "San Francisco", Continent: "North America", Latitude: 37.7749, Longitude: -122.4194, Status: Active, LaunchDate: time.Date(2016, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "sfo3", Name: "San Francisco 3", Country: "United States", City: "San Francisco", Continent: "North America", Latitude: 37.7749, Longitude: -122.4194, Status: Active, LaunchDate: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "tor1", Name: "Toronto 1", Country: "Canada", City: "Toronto", Continent: "North America", Latitude: 43.6532, Longitude: -79.3832, Status: Active, LaunchDate: time.Date(2015, 10, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "atl1", Name: "Atlanta 1", Country: "United States", City: "Atlanta", Continent: "North America", Latitude: 33.749, Longitude: -84.388, Status: Active, LaunchDate: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "ams2", Name: "Amsterdam 2", Country: "Netherlands", City: "Amsterdam", Continent: "Europe", Latitude: 52.3676, Longitude: 4.9041, Status: Deprecated, LaunchDate: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "ams3", Name: "Amsterdam 3", Country: "Netherlands", City: "Amsterdam", Continent: "Europe", Latitude: 52.3676, Longitude: 4.9041, Status: Active, LaunchDate: time.Date(2014, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "lon1", Name: "London 1", Country: "United Kingdom", City: "London", Continent: "Europe", Latitude: 51.5074, Longitude: -0.1278, Status: Active, LaunchDate: time.Date(2014, 7, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "fra1", Name: "Frankfurt 1", Country: "Germany", City: "Frankfurt", Continent: "Europe", Latitude: 50.1109, Longitude: 8.6821, Status: Active, LaunchDate: time.Date(2015, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "sgp1", Name: "Singapore 1", Country: "Singapore", City: "Singapore", Continent: "Asia", Latitude: 1.3521, Longitude: 103.8198, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "blr1", Name: "Bangalore 1", Country: "India", City: "Bangalore", Continent: "Asia", Latitude: 12.9716, Longitude: 77.5946, Status: Active, LaunchDate: time.Date(2016, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "digitalocean", Code: "syd1", Name: "Sydney 1", Country: "Australia", City: "Sydney", Continent: "Oceania", Latitude: -33.8688, Longitude: 151.2093, Status: Active, LaunchDate: time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	// Akamai Cloud (Linode) Regions
+	{Provider: "linode", Code: "us-east", Name: "Newark, NJ", Country: "United States", City: "Newark", Continent: "North America", Latitude: 40.7357, Longitude: -74.1724, Status: Active, LaunchDate: time.Date(2003, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "us-central", Name: "Dallas, TX", Country: "United States", City: "Dallas", Continent: "North America", Latitude: 32.7767, Longitude: -96.797, Status: Active, LaunchDate: time.Date(2003, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "us-west", Name: "Fremont, CA", Country: "United States", City: "Fremont", Continent: "North America", Latitude: 37.5485, Longitude: -121.9886, Status: Active, LaunchDate: time.Date(2008, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "us-southeast", Name: "Atlanta, GA", Country: "United States", City: "Atlanta", Continent: "North America", Latitude: 33.749, Longitude: -84.388, Status: Active, LaunchDate: time.Date(2008, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "us-iad", Name: "Washington, DC", Country: "United States", City: "Washington", Continent: "North America", Latitude: 38.9072, Longitude: -77.0369, Status: Active, LaunchDate: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "us-ord", Name: "Chicago, IL", Country: "United States", City: "Chicago", Continent: "North America", Latitude: 41.8781, Longitude: -87.6298, Status: Active, LaunchDate: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "us-sea", Name: "Seattle, WA", Country: "United States", City: "Seattle", Continent: "North America", Latitude: 47.6062, Longitude: -122.3321, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "us-lax", Name: "Los Angeles, CA", Country: "United States", City: "Los Angeles", Continent: "North America", Latitude: 34.0522, Longitude: -118.2437, Status: Active, LaunchDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "us-mia", Name: "Miami, FL", Country: "United States", City: "Miami", Continent: "North America", Latitude: 25.7617, Longitude: -80.1918, Status: Active, LaunchDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "ca-central", Name: "Toronto, ON", Country: "Canada", City: "Toronto", Continent: "North America", Latitude: 43.6532, Longitude: -79.3832, Status: Active, LaunchDate: time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "br-gru", Name: "São Paulo, BR", Country: "Brazil", City: "São Paulo", Continent: "South America", Latitude: -23.5505, Longitude: -46.6333, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "eu-west", Name: "London, UK", Country: "United Kingdom", City: "London", Continent: "Europe", Latitude: 51.5074, Longitude: -0.1278, Status: Active, LaunchDate: time.Date(2010, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "eu-central", Name: "Frankfurt, DE", Country: "Germany", City: "Frankfurt", Continent: "Europe", Latitude: 50.1109, Longitude: 8.6821, Status: Active, LaunchDate: time.Date(2015, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "fr-par", Name: "Paris, FR", Country: "France", City: "Paris", Continent: "Europe", Latitude: 48.8566, Longitude: 2.3522, Status: Active, LaunchDate: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "nl-ams", Name: "Amsterdam, NL", Country: "Netherlands", City: "Amsterdam", Continent: "Europe", Latitude: 52.3676, Longitude: 4.9041, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "se-sto", Name: "Stockholm, SE", Country: "Sweden", City: "Stockholm", Continent: "Europe", Latitude: 59.3293, Longitude: 18.0686, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "es-mad", Name: "Madrid, ES", Country: "Spain", City: "Madrid", Continent: "Europe", Latitude: 40.4168, Longitude: -3.7038, Status: Active, LaunchDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "it-mil", Name: "Milan, IT", Country: "Italy", City: "Milan", Continent: "Europe", Latitude: 45.4642, Longitude: 9.19, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "ap-south", Name: "Singapore, SG", Country: "Singapore", City: "Singapore", Continent: "Asia", Latitude: 1.3521, Longitude: 103.8198, Status: Active, LaunchDate: time.Date(2015, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "ap-northeast", Name: "Tokyo, JP", Country: "Japan", City: "Tokyo", Continent: "Asia", Latitude: 35.6762, Longitude: 139.6503, Status: Active, LaunchDate: time.Date(2016, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "ap-west", Name: "Mumbai, IN", Country: "India", City: "Mumbai", Continent: "Asia", Latitude: 19.076, Longitude: 72.8777, Status: Active, LaunchDate: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "in-maa", Name: "Chennai, IN", Country: "India", City: "Chennai", Continent: "Asia", Latitude: 13.0827, Longitude: 80.2707, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "jp-osa", Name: "Osaka, JP", Country: "Japan", City: "Osaka", Continent: "Asia", Latitude: 34.6937, Longitude: 135.5023, Status: Active, LaunchDate: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "id-cgk", Name: "Jakarta, ID", Country: "Indonesia", City: "Jakarta", Continent: "Asia", Latitude: -6.2088, Longitude: 106.8456, Status: Active, LaunchDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "linode", Code: "ap-southeast", Name: "Sydney, AU", Country: "Australia", City: "Sydney", Continent: "Oceania", Latitude: -33.8688, Longitude: 151.2093, Status: Active, LaunchDate: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	// Vultr Regions
+	{Provider: "vultr", Code: "ewr", Name: "New Jersey", Country: "United States", City: "Piscataway", Continent: "North America", Latitude: 40.5549, Longitude: -74.4643, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "ord", Name: "Chicago", Country: "United States", City: "Chicago", Continent: "North America", Latitude: 41.8781, Longitude: -87.6298, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "dfw", Name: "Dallas", Country: "United States", City: "Dallas", Continent: "North America", Latitude: 32.7767, Longitude: -96.797, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "atl", Name: "Atlanta", Country: "United States", City: "Atlanta", Continent: "North America", Latitude: 33.749, Longitude: -84.388, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "mia", Name: "Miami", Country: "United States", City: "Miami", Continent: "North America", Latitude: 25.7617, Longitude: -80.1918, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "sea", Name: "Seattle", Country: "United States", City: "Seattle", Continent: "North America", Latitude: 47.6062, Longitude: -122.3321, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "sjc", Name: "Silicon Valley", Country: "United States", City: "San Jose", Continent: "North America", Latitude: 37.3382, Longitude: -121.8863, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "lax", Name: "Los Angeles", Country: "United States", City: "Los Angeles", Continent: "North America", Latitude: 34.0522, Longitude: -118.2437, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "hnl", Name: "Honolulu", Country: "United States", City: "Honolulu", Continent: "North America", Latitude: 21.3069, Longitude: -157.8583, Status: Active, LaunchDate: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "yto", Name: "Toronto", Country: "Canada", City: "Toronto", Continent: "North America", Latitude: 43.6532, Longitude: -79.3832, Status: Active, LaunchDate: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "mex", Name: "Mexico City", Country: "Mexico", City: "Mexico City", Continent: "North America", Latitude: 19.4326, Longitude: -99.1332, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "sao", Name: "São Paulo", Country: "Brazil", City: "São Paulo", Continent: "South America", Latitude: -23.5505, Longitude: -46.6333, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "scl", Name: "Santiago", Country: "Chile", City: "Santiago", Continent: "South America", Latitude: -33.4489, Longitude: -70.6693, Status: Active, LaunchDate: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "ams", Name: "Amsterdam", Country: "Netherlands", City: "Amsterdam", Continent: "Europe", Latitude: 52.3676, Longitude: 4.9041, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "lhr", Name: "London", Country: "United Kingdom", City: "London", Continent: "Europe", Latitude: 51.5074, Longitude: -0.1278, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "man", Name: "Manchester", Country: "United Kingdom", City: "Manchester", Continent: "Europe", Latitude: 53.4808, Longitude: -2.2426, Status: Active, LaunchDate: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "fra", Name: "Frankfurt", Country: "Germany", City: "Frankfurt", Continent: "Europe", Latitude: 50.1109, Longitude: 8.6821, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "cdg", Name: "Paris", Country: "France", City: "Paris", Continent: "Europe", Latitude: 48.8566, Longitude: 2.3522, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "waw", Name: "Warsaw", Country: "Poland", City: "Warsaw", Continent: "Europe", Latitude: 52.2297, Longitude: 21.0122, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "mad", Name: "Madrid", Country: "Spain", City: "Madrid", Continent: "Europe", Latitude: 40.4168, Longitude: -3.7038, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "sto", Name: "Stockholm", Country: "Sweden", City: "Stockholm", Continent: "Europe", Latitude: 59.3293, Longitude: 18.0686, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "nrt", Name: "Tokyo", Country: "Japan", City: "Tokyo", Continent: "Asia", Latitude: 35.6762, Longitude: 139.6503, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "osa", Name: "Osaka", Country: "Japan", City: "Osaka", Continent: "Asia", Latitude: 34.6937, Longitude: 135.5023, Status: Active, LaunchDate: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "icn", Name: "Seoul", Country: "South Korea", City: "Seoul", Continent: "Asia", Latitude: 37.5665, Longitude: 126.978, Status: Active, LaunchDate: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "sgp", Name: "Singapore", Country: "Singapore", City: "Singapore", Continent: "Asia", Latitude: 1.3521, Longitude: 103.8198, Status: Active, LaunchDate: time.Date(2016, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "bom", Name: "Mumbai", Country: "India", City: "Mumbai", Continent: "Asia", Latitude: 19.076, Longitude: 72.8777, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "del", Name: "Delhi NCR", Country: "India", City: "Delhi", Continent: "Asia", Latitude: 28.7041, Longitude: 77.1025, Status: Active, LaunchDate: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "blr", Name: "Bangalore", Country: "India", City: "Bangalore", Continent: "Asia", Latitude: 12.9716, Longitude: 77.5946, Status: Active, LaunchDate: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "tlv", Name: "Tel Aviv", Country: "Israel", City: "Tel Aviv", Continent: "Asia", Latitude: 32.0853, Longitude: 34.7818, Status: Active, LaunchDate: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "jnb", Name: "Johannesburg", Country: "South Africa", City: "Johannesburg", Continent: "Africa", Latitude: -26.2041, Longitude: 28.0473, Status: Active, LaunchDate: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "syd", Name: "Sydney", Country: "Australia", City: "Sydney", Continent: "Oceania", Latitude: -33.8688, Longitude: 151.2093, Status: Active, LaunchDate: time.Date(2014, 2, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "vultr", Code: "mel", Name: "Melbourne", Country: "Australia", City: "Melbourne", Continent: "Oceania", Latitude: -37.8136, Longitude: 144.9631, Status: Active, LaunchDate: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	// Fly.io Regions
+	{Provider: "fly", Code: "iad", Name: "Ashburn, Virginia (US)", Country: "United States", City: "Ashburn", Continent: "North America", Latitude: 39.0438, Longitude: -77.4874, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "ewr", Name: "Secaucus, NJ (US)", Country: "United States", City: "Secaucus", Continent: "North America", Latitude: 40.7895, Longitude: -74.0565, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "bos", Name: "Boston, Massachusetts (US)", Country: "United States", City: "Boston", Continent: "North America", Latitude: 42.3601, Longitude: -71.0589, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "atl", Name: "Atlanta, Georgia (US)", Country: "United States", City: "Atlanta", Continent: "North America", Latitude: 33.749, Longitude: -84.388, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "mia", Name: "Miami, Florida (US)", Country: "United States", City: "Miami", Continent: "North America", Latitude: 25.7617, Longitude: -80.1918, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "ord", Name: "Chicago, Illinois (US)", Country: "United States", City: "Chicago", Continent: "North America", Latitude: 41.8781, Longitude: -87.6298, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "dfw", Name: "Dallas, Texas (US)", Country: "United States", City: "Dallas", Continent: "North America", Latitude: 32.7767, Longitude: -96.797, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "den", Name: "Denver, Colorado (US)", Country: "United States", City: "Denver", Continent: "North America", Latitude: 39.7392, Longitude: -104.9903, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "phx", Name: "Phoenix, Arizona (US)", Country: "United States", City: "Phoenix", Continent: "North America", Latitude: 33.4484, Longitude: -112.074, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "lax", Name: "Los Angeles, California (US)", Country: "United States", City: "Los Angeles", Continent: "North America", Latitude: 34.0522, Longitude: -118.2437, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "sjc", Name: "San Jose, California (US)", Country: "United States", City: "San Jose", Continent: "North America", Latitude: 37.3382, Longitude: -121.8863, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "sea", Name: "Seattle, Washington (US)", Country: "United States", City: "Seattle", Continent: "North America", Latitude: 47.6062, Longitude: -122.3321, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "yul", Name: "Montreal, Canada", Country: "Canada", City: "Montréal", Continent: "North America", Latitude: 45.5019, Longitude: -73.5674, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "yyz", Name: "Toronto, Canada", Country: "Canada", City: "Toronto", Continent: "North America", Latitude: 43.6532, Longitude: -79.3832, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "gdl", Name: "Guadalajara, Mexico", Country: "Mexico", City: "Guadalajara", Continent: "North America", Latitude: 20.6597, Longitude: -103.3496, Status: Active, LaunchDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "qro", Name: "Querétaro, Mexico", Country: "Mexico", City: "Querétaro", Continent: "North America", Latitude: 20.5888, Longitude: -100.3899, Status: Active, LaunchDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "bog", Name: "Bogotá, Colombia", Country: "Colombia", City: "Bogotá", Continent: "South America", Latitude: 4.711, Longitude: -74.0721, Status: Active, LaunchDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "gru", Name: "Sao Paulo, Brazil", Country: "Brazil", City: "São Paulo", Continent: "South America", Latitude: -23.5505, Longitude: -46.6333, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "gig", Name: "Rio de Janeiro, Brazil", Country: "Brazil", City: "Rio de Janeiro", Continent: "South America", Latitude: -22.9068, Longitude: -43.1729, Status: Active, LaunchDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "eze", Name: "Ezeiza, Argentina", Country: "Argentina", City: "Buenos Aires", Continent: "South America", Latitude: -34.6037, Longitude: -58.3816, Status: Active, LaunchDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "scl", Name: "Santiago, Chile", Country: "Chile", City: "Santiago", Continent: "South America", Latitude: -33.4489, Longitude: -70.6693, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "ams", Name: "Amsterdam, Netherlands", Country: "Netherlands", City: "Amsterdam", Continent: "Europe", Latitude: 52.3676, Longitude: 4.9041, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "arn", Name: "Stockholm, Sweden", Country: "Sweden", City: "Stockholm", Continent: "Europe", Latitude: 59.3293, Longitude: 18.0686, Status: Active, LaunchDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "cdg", Name: "Paris, France", Country: "France", City: "Paris", Continent: "Europe", Latitude: 48.8566, Longitude: 2.3522, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "fra", Name: "Frankfurt, Germany", Country: "Germany", City: "Frankfurt", Continent: "Europe", Latitude: 50.1109, Longitude: 8.6821, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "lhr", Name: "London, United Kingdom", Country: "United Kingdom", City: "London", Continent: "Europe", Latitude: 51.5074, Longitude: -0.1278, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "mad", Name: "Madrid, Spain", Country: "Spain", City: "Madrid", Continent: "Europe", Latitude: 40.4168, Longitude: -3.7038, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "otp", Name: "Bucharest, Romania", Country: "Romania", City: "Bucharest", Continent: "Europe", Latitude: 44.4268, Longitude: 26.1025, Status: Active, LaunchDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "waw", Name: "Warsaw, Poland", Country: "Poland", City: "Warsaw", Continent: "Europe", Latitude: 52.2297, Longitude: 21.0122, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "bom", Name: "Mumbai, India", Country: "India", City: "Mumbai", Continent: "Asia", Latitude: 19.076, Longitude: 72.8777, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "hkg", Name: "Hong Kong, Hong Kong", Country: "Hong Kong", City: "Hong Kong", Continent: "Asia", Latitude: 22.3193, Longitude: 114.1694, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "nrt", Name: "Tokyo, Japan", Country: "Japan", City: "Tokyo", Continent: "Asia", Latitude: 35.6762, Longitude: 139.6503, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "sin", Name: "Singapore, Singapore", Country: "Singapore", City: "Singapore", Continent: "Asia", Latitude: 1.3521, Longitude: 103.8198, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "jnb", Name: "Johannesburg, South Africa", Country: "South Africa", City: "Johannesburg", Continent: "Africa", Latitude: -26.2041, Longitude: 28.0473, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	{Provider: "fly", Code: "syd", Name: "Sydney, Australia", Country: "Australia", City: "Sydney", Continent: "Oceania", Latitude: -33.8688, Longitude: 151.2093, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{}},
+	// Tencent Cloud Regions
+	{Provider: "tencent", Code: "ap-guangzhou", Name: "South China (Guangzhou)", Country: "China", City: "Guangzhou", Continent: "Asia", Latitude: 23.1291, Longitude: 113.2644, Status: Active, LaunchDate: time.Date(2013, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-guangzhou-3", "ap-guangzhou-4", "ap-guangzhou-6", "ap-guangzhou-7"}},
+	{Provider: "tencent", Code: "ap-shanghai", Name: "East China (Shanghai)", Country: "China", City: "Shanghai", Continent: "Asia", Latitude: 31.2304, Longitude: 121.4737, Status: Active, LaunchDate: time.Date(2014, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-shanghai-2", "ap-shanghai-3", "ap-shanghai-4", "ap-shanghai-5", "ap-shanghai-8"}},
+	{Provider: "tencent", Code: "ap-nanjing", Name: "East China (Nanjing)", Country: "China", City: "Nanjing", Continent: "Asia", Latitude: 32.0603, Longitude: 118.7969, Status: Active, LaunchDate: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-nanjing-1", "ap-nanjing-2", "ap-nanjing-3"}},
+	{Provider: "tencent", Code: "ap-beijing", Name: "North China (Beijing)", Country: "China", City: "Beijing", Continent: "Asia", Latitude: 39.9042, Longitude: 116.4074, Status: Active, LaunchDate: time.Date(2015, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-beijing-3", "ap-beijing-5", "ap-beijing-6", "ap-beijing-7"}},
+	{Provider: "tencent", Code: "ap-chengdu", Name: "Southwest China (Chengdu)", Country: "China", City: "Chengdu", Continent: "Asia", Latitude: 30.5728, Longitude: 104.0668, Status: Active, LaunchDate: time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-chengdu-1", "ap-chengdu-2"}},
+	{Provider: "tencent", Code: "ap-chongqing", Name: "Southwest China (Chongqing)", Country: "China", City: "Chongqing", Continent: "Asia", Latitude: 29.563, Longitude: 106.5516, Status: Active, LaunchDate: time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-chongqing-1"}},
+	{Provider: "tencent", Code: "ap-hongkong", Name: "Hong Kong, China", Country: "Hong Kong", City: "Hong Kong", Continent: "Asia", Latitude: 22.3193, Longitude: 114.1694, Status: Active, LaunchDate: time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-hongkong-1", "ap-hongkong-2", "ap-hongkong-3"}},
+	{Provider: "tencent", Code: "ap-singapore", Name: "Southeast Asia (Singapore)", Country: "Singapore", City: "Singapore", Continent: "Asia", Latitude: 1.3521, Longitude: 103.8198, Status: Active, LaunchDate: time.Date(2016, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-singapore-1", "ap-singapore-2", "ap-singapore-3", "ap-singapore-4"}},
+	{Provider: "tencent", Code: "ap-jakarta", Name: "Southeast Asia (Jakarta)", Country: "Indonesia", City: "Jakarta", Continent: "Asia", Latitude: -6.2088, Longitude: 106.8456, Status: Active, LaunchDate: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-jakarta-1", "ap-jakarta-2"}},
+	{Provider: "tencent", Code: "ap-bangkok", Name: "Southeast Asia (Bangkok)", Country: "Thailand", City: "Bangkok", Continent: "Asia", Latitude: 13.7563, Longitude: 100.5018, Status: Active, LaunchDate: time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-bangkok-1", "ap-bangkok-2"}},
+	{Provider: "tencent", Code: "ap-seoul", Name: "Northeast Asia (Seoul)", Country: "South Korea", City: "Seoul", Continent: "Asia", Latitude: 37.5665, Longitude: 126.978, Status: Active, LaunchDate: time.Date(2017, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-seoul-1", "ap-seoul-2"}},
+	{Provider: "tencent", Code: "ap-tokyo", Name: "Northeast Asia (Tokyo)", Country: "Japan", City: "Tokyo", Continent: "Asia", Latitude: 35.6762, Longitude: 139.6503, Status: Active, LaunchDate: time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-tokyo-1", "ap-tokyo-2"}},
+	{Provider: "tencent", Code: "ap-mumbai", Name: "South Asia (Mumbai)", Country: "India", City: "Mumbai", Continent: "Asia", Latitude: 19.076, Longitude: 72.8777, Status: Active, LaunchDate: time.Date(2017, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-mumbai-1", "ap-mumbai-2"}},
+	{Provider: "tencent", Code: "na-siliconvalley", Name: "US West (Silicon Valley)", Country: "United States", City: "Silicon Valley", Continent: "North America", Latitude: 37.4419, Longitude: -122.143, Status: Active, LaunchDate: time.Date(2016, 12, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"na-siliconvalley-1", "na-siliconvalley-2"}},
+	{Provider: "tencent", Code: "na-ashburn", Name: "US East (Virginia)", Country: "United States", City: "Ashburn", Continent: "North America", Latitude: 39.0438, Longitude: -77.4874, Status: Active, LaunchDate: time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"na-ashburn-1", "na-ashburn-2"}},
+	{Provider: "tencent", Code: "sa-saopaulo", Name: "South America (São Paulo)", Country: "Brazil", City: "São Paulo", Continent: "South America", Latitude: -23.5505, Longitude: -46.6333, Status: Active, LaunchDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"sa-saopaulo-1"}},
+	{Provider: "tencent", Code: "eu-frankfurt", Name: "Europe (Frankfurt)", Country: "Germany", City: "Frankfurt", Continent: "Europe", Latitude: 50.1109, Longitude: 8.6821, Status: Active, LaunchDate: time.Date(2017, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-frankfurt-1", "eu-frankfurt-2"}},
+	// Huawei Cloud Regions
+	{Provider: "huawei", Code: "cn-north-1", Name: "CN North-Beijing1", Country: "China", City: "Beijing", Continent: "Asia", Latitude: 39.9042, Longitude: 116.4074, Status: Active, LaunchDate: time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-north-1a", "cn-north-1b", "cn-north-1c"}},
+	{Provider: "huawei", Code: "cn-north-4", Name: "CN North-Beijing4", Country: "China", City: "Beijing", Continent: "Asia", Latitude: 39.9042, Longitude: 116.4074, Status: Active, LaunchDate: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-north-4a", "cn-north-4b", "cn-north-4c", "cn-north-4g"}},
+	{Provider: "huawei", Code: "cn-north-9", Name: "CN North-Ulanqab1", Country: "China", City: "Ulanqab", Continent: "Asia", Latitude: 41.0342, Longitude: 113.1325, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-north-9a", "cn-north-9b", "cn-north-9c"}},
+	{Provider: "huawei", Code: "cn-east-2", Name: "CN East-Shanghai2", Country: "China", City: "Shanghai", Continent: "Asia", Latitude: 31.2304, Longitude: 121.4737, Status: Active, LaunchDate: time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-east-2a", "cn-east-2b", "cn-east-2c", "cn-east-2d"}},
+	{Provider: "huawei", Code: "cn-east-3", Name: "CN East-Shanghai1", Country: "China", City: "Shanghai", Continent: "Asia", Latitude: 31.2304, Longitude: 121.4737, Status: Active, LaunchDate: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-east-3a", "cn-east-3b", "cn-east-3c", "cn-east-3d"}},
+	{Provider: "huawei", Code: "cn-south-1", Name: "CN South-Guangzhou", Country: "China", City: "Guangzhou", Continent: "Asia", Latitude: 23.1291, Longitude: 113.2644, Status: Active, LaunchDate: time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-south-1a", "cn-south-1c", "cn-south-1e", "cn-south-1f"}},
+	{Provider: "huawei", Code: "cn-southwest-2", Name: "CN Southwest-Guiyang1", Country: "China", City: "Guiyang", Continent: "Asia", Latitude: 26.647, Longitude: 106.6302, Status: Active, LaunchDate: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"cn-southwest-2a", "cn-southwest-2b", "cn-southwest-2c"}},
+	{Provider: "huawei", Code: "ap-southeast-1", Name: "CN-Hong Kong", Country: "Hong Kong", City: "Hong Kong", Continent: "Asia", Latitude: 22.3193, Longitude: 114.1694, Status: Active, LaunchDate: time.Date(2017, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-1a", "ap-southeast-1b", "ap-southeast-1c"}},
+	{Provider: "huawei", Code: "ap-southeast-2", Name: "AP-Bangkok", Country: "Thailand", City: "Bangkok", Continent: "Asia", Latitude: 13.7563, Longitude: 100.5018, Status: Active, LaunchDate: time.Date(2018, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-2a", "ap-southeast-2b", "ap-southeast-2c"}},
+	{Provider: "huawei", Code: "ap-southeast-3", Name: "AP-Singapore", Country: "Singapore", City: "Singapore", Continent: "Asia", Latitude: 1.3521, Longitude: 103.8198, Status: Active, LaunchDate: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-3a", "ap-southeast-3b", "ap-southeast-3c"}},
+	{Provider: "huawei", Code: "ap-southeast-4", Name: "AP-Jakarta", Country: "Indonesia", City: "Jakarta", Continent: "Asia", Latitude: -6.2088, Longitude: 106.8456, Status: Active, LaunchDate: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"ap-southeast-4a", "ap-southeast-4b", "ap-southeast-4c"}},
+	{Provider: "huawei", Code: "me-east-1", Name: "ME-Riyadh", Country: "Saudi Arabia", City: "Riyadh", Continent: "Asia", Latitude: 24.7136, Longitude: 46.6753, Status: Active, LaunchDate: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"me-east-1a", "me-east-1b", "me-east-1c"}},
+	{Provider: "huawei", Code: "tr-west-1", Name: "TR-Istanbul", Country: "Turkey", City: "Istanbul", Continent: "Europe", Latitude: 41.0082, Longitude: 28.9784, Status: Active, LaunchDate: time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"tr-west-1a", "tr-west-1b", "tr-west-1c"}},
+	{Provider: "huawei", Code: "eu-west-0", Name: "EU-Paris", Country: "France", City: "Paris", Continent: "Europe", Latitude: 48.8566, Longitude: 2.3522, Status: Active, LaunchDate: time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-west-0a", "eu-west-0b", "eu-west-0c"}},
+	{Provider: "huawei", Code: "eu-west-101", Name: "EU-Dublin", Country: "Ireland", City: "Dublin", Continent: "Europe", Latitude: 53.3498, Longitude: -6.2603, Status: Active, LaunchDate: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"eu-west-101a", "eu-west-101b", "eu-west-101c"}},
+	{Provider: "huawei", Code: "af-south-1", Name: "AF-Johannesburg", Country: "South Africa", City: "Johannesburg", Continent: "Africa", Latitude: -26.2041, Longitude: 28.0473, Status: Active, LaunchDate: time.Date(2019, 11, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"af-south-1a", "af-south-1b", "af-south-1c"}},
+	{Provider: "huawei", Code: "na-mexico-1", Name: "LA-Mexico City1", Country: "Mexico", City: "Mexico City", Continent: "North America", Latitude: 19.4326, Longitude: -99.1332, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"na-mexico-1a", "na-mexico-1b"}},
+	{Provider: "huawei", Code: "la-north-2", Name: "LA-Mexico City2", Country: "Mexico", City: "Mexico City", Continent: "North America", Latitude: 19.4326, Longitude: -99.1332, Status: Active, LaunchDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"la-north-2a", "la-north-2b", "la-north-2c"}},
+	{Provider: "huawei", Code: "sa-brazil-1", Name: "LA-Sao Paulo1", Country: "Brazil", City: "São Paulo", Continent: "South America", Latitude: -23.5505, Longitude: -46.6333, Status: Active, LaunchDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"sa-brazil-1a", "sa-brazil-1b", "sa-brazil-1c"}},
+	{Provider: "huawei", Code: "la-south-2", Name: "LA-Santiago", Country: "Chile", City: "Santiago", Continent: "South America", Latitude: -33.4489, Longitude: -70.6693, Status: Active, LaunchDate: time.Date(2019, 9, 1, 0, 0, 0, 0, time.UTC), Zones: []string{"la-south-2a", "la-south-2b", "la-south-2c"}},
 }
 
+// catalog holds every region in declaration order with defaults applied.
+var catalog = func() Set {
+	s := make(Set, len(regions))
+	for i, r := range regions {
+		if r.Partition == "" {
+			r.Partition = PartitionCommercial
+		}
+		s[i] = r
+	}
+	return s
+}()
+
 var regionRegistry = func() map[Code][]Region {
-	m := make(map[Code][]Region, len(regions))
-	for _, r := range regions {
+	m := make(map[Code][]Region, len(catalog))
+	for _, r := range catalog {
 		m[r.Code] = append(m[r.Code], r)
 	}
 	return m
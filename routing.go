@@ -0,0 +1,232 @@
+package where
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AssignOptions tunes Assign.
+type AssignOptions struct {
+	// Failover is the number of fallback regions listed after the primary,
+	// nearest first; zero means 2.
+	Failover int
+	// Capacity caps the total point weight a region takes as primary. Regions
+	// not listed are unlimited.
+	Capacity map[RegionID]float64
+}
+
+// Assignment routes one point to its regions, primary first.
+type Assignment struct {
+	Point       PopulationPoint `json:"point"`
+	Regions     []RegionID      `json:"regions"`
+	DistancesKm []float64       `json:"distancesKm"`
+	// Overflow reports that no region had capacity left for the point, so it
+	// went to its nearest region regardless.
+	Overflow bool `json:"overflow,omitempty"`
+}
+
+// RoutingTable is the result of Assign, in the order of the points.
+type RoutingTable []Assignment
+
+// Assign maps each point to its nearest region of the set, followed by the
+// next nearest as failover: a Voronoi partition of the points over the
+// regions. With capacities, point-region pairs are taken closest first and a
+// point whose nearest regions are full spills over to the next one with room.
+// Failover regions ignore capacity. The set is not modified.
+func Assign(points Population, s Set, opts AssignOptions) RoutingTable {
+	failover := opts.Failover
+	if failover <= 0 {
+		failover = 2
+	}
+	table := make(RoutingTable, len(points))
+	if len(s) == 0 {
+		for i, pt := range points {
+			table[i] = Assignment{Point: pt, Regions: []RegionID{}, DistancesKm: []float64{}}
+		}
+		return table
+	}
+
+	// ranked holds, for every point, the indexes of the regions nearest first.
+	ranked := make([][]int, len(points))
+	dist := make([][]float64, len(points))
+	for i, pt := range points {
+		dist[i] = make([]float64, len(s))
+		ranked[i] = make([]int, len(s))
+		for j, r := range s {
+			dist[i][j] = haversineDistance(pt.Latitude, pt.Longitude, r.Latitude, r.Longitude)
+			ranked[i][j] = j
+		}
+		d := dist[i]
+		sort.SliceStable(ranked[i], func(a, b int) bool {
+			return d[ranked[i][a]] < d[ranked[i][b]]
+		})
+	}
+
+	primary := make([]int, len(points))
+	for i := range primary {
+		primary[i] = ranked[i][0]
+	}
+	overflow := make([]bool, len(points))
+	if len(opts.Capacity) > 0 {
+		assignWithCapacity(points, s, opts.Capacity, dist, ranked, primary, overflow)
+	}
+
+	for i, pt := range points {
+		order := []int{primary[i]}
+		for _, j := range ranked[i] {
+			if len(order) > failover {
+				break
+			}
+			if j != primary[i] {
+				order = append(order, j)
+			}
+		}
+		a := Assignment{Point: pt, Overflow: overflow[i]}
+		for _, j := range order {
+			a.Regions = append(a.Regions, s[j].ID())
+			a.DistancesKm = append(a.DistancesKm, dist[i][j])
+		}
+		table[i] = a
+	}
+	return table
+}
+
+// assignWithCapacity fills primary with the closest region that still has
+// room for each point, taking point-region pairs in order of distance.
+func assignWithCapacity(points Population, s Set, capacity map[RegionID]float64, dist [][]float64, ranked [][]int, primary []int, overflow []bool) {
+	remaining := make([]float64, len(s))
+	for j, r := range s {
+		remaining[j] = math.Inf(1)
+		if c, ok := capacity[r.ID()]; ok {
+			remaining[j] = c
+		}
+	}
+	type pair struct{ point, region int }
+	pairs := make([]pair, 0, len(points)*len(s))
+	for i := range points {
+		for j := range s {
+			pairs = append(pairs, pair{i, j})
+		}
+	}
+	sort.SliceStable(pairs, func(a, b int) bool {
+		return dist[pairs[a].point][pairs[a].region] < dist[pairs[b].point][pairs[b].region]
+	})
+
+	assigned := make([]bool, len(points))
+	for _, p := range pairs {
+		if assigned[p.point] || points[p.point].Weight > remaining[p.region] {
+			continue
+		}
+		assigned[p.point] = true
+		primary[p.point] = p.region
+		remaining[p.region] -= points[p.point].Weight
+	}
+	for i := range points {
+		if !assigned[i] {
+			primary[i] = ranked[i][0]
+			overflow[i] = true
+		}
+	}
+}
+
+// CountryPoints builds one point per country, keyed by ISO 3166-1 alpha-2
+// code, at the population-weighted center of its cities in the embedded
+// world-cities dataset. The label is the upper-case code, so a routing table
+// built from the points reads country to regions, as DNS geo-steering wants.
+func CountryPoints(weights map[string]float64) (Population, error) {
+	var points Population
+	for code, weight := range weights {
+		cities, err := CountryPopulation(map[string]float64{code: 1})
+		if err != nil {
+			return nil, err
+		}
+		lat, lng := weightedCentroid(cities)
+		points = append(points, PopulationPoint{Label: strings.ToUpper(code), Latitude: lat, Longitude: lng, Weight: weight})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Label < points[j].Label
+	})
+	return points, nil
+}
+
+// weightedCentroid is the weighted counterpart of centroid for population
+// points.
+func weightedCentroid(p Population) (float64, float64) {
+	const rad = math.Pi / 180
+	var x, y, z float64
+	for _, pt := range p {
+		lat, lng := pt.Latitude*rad, pt.Longitude*rad
+		x += pt.Weight * math.Cos(lat) * math.Cos(lng)
+		y += pt.Weight * math.Cos(lat) * math.Sin(lng)
+		z += pt.Weight * math.Sin(lat)
+	}
+	return math.Atan2(z, math.Hypot(x, y)) / rad, math.Atan2(y, x) / rad
+}
+
+// key names the point of an assignment in exported tables: its label, or
+// its coordinates when unlabeled.
+func (a Assignment) key() string {
+	if a.Point.Label != "" {
+		return a.Point.Label
+	}
+	return strconv.FormatFloat(a.Point.Latitude, 'f', -1, 64) + "," + strconv.FormatFloat(a.Point.Longitude, 'f', -1, 64)
+}
+
+// Routes returns the table as a map from point label to region IDs, primary
+// first. Unlabeled points are keyed by "lat,lng".
+func (t RoutingTable) Routes() map[string][]RegionID {
+	routes := make(map[string][]RegionID, len(t))
+	for _, a := range t {
+		routes[a.key()] = a.Regions
+	}
+	return routes
+}
+
+// WriteJSON writes the table as a JSON object from point label to the
+// ordered region IDs, e.g. {"DE": ["aws/eu-central-1", "aws/eu-central-2"]}.
+func (t RoutingTable) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t.Routes())
+}
+
+// WriteCSV writes the table as CSV with a header row: the point label, the
+// primary region and the failover regions, one row per point.
+func (t RoutingTable) WriteCSV(w io.Writer) error {
+	width := 0
+	for _, a := range t {
+		if len(a.Regions) > width {
+			width = len(a.Regions)
+		}
+	}
+	header := []string{"point"}
+	for i := 0; i < width; i++ {
+		if i == 0 {
+			header = append(header, "primary")
+		} else {
+			header = append(header, fmt.Sprintf("failover_%d", i))
+		}
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, a := range t {
+		row := make([]string, width+1)
+		row[0] = a.key()
+		for i, id := range a.Regions {
+			row[i+1] = string(id)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
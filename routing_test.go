@@ -0,0 +1,119 @@
+package where
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAssign(t *testing.T) {
+	set := Set{
+		{Code: "fra", Provider: "test", Latitude: 50.1, Longitude: 8.7},
+		{Code: "nrt", Provider: "test", Latitude: 35.7, Longitude: 139.7},
+		{Code: "iad", Provider: "test", Latitude: 39.0, Longitude: -77.5},
+		{Code: "syd", Provider: "test", Latitude: -33.9, Longitude: 151.2},
+	}
+	before := append(Set{}, set...)
+	points := Population{
+		{Label: "Berlin", Latitude: 52.5, Longitude: 13.4, Weight: 1},
+		{Label: "Osaka", Latitude: 34.7, Longitude: 135.5, Weight: 1},
+	}
+
+	table := Assign(points, set, AssignOptions{})
+	want := map[string][]RegionID{
+		"Berlin": {"test/fra", "test/iad", "test/nrt"},
+		"Osaka":  {"test/nrt", "test/syd", "test/fra"},
+	}
+	if got := table.Routes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Assign().Routes() = %v, want %v", got, want)
+	}
+	if d := table[0].DistancesKm; len(d) != 3 || d[0] > d[1] || d[1] > d[2] {
+		t.Errorf("Assign()[0].DistancesKm = %v, want ascending", d)
+	}
+	if !reflect.DeepEqual(set, before) {
+		t.Error("Assign() modified the set")
+	}
+	if got := Assign(points, set, AssignOptions{Failover: 1}); len(got[0].Regions) != 2 {
+		t.Errorf("Assign(Failover: 1) = %v, want 2 regions", got[0].Regions)
+	}
+	if got := Assign(points, Set{}, AssignOptions{}); len(got) != 2 || len(got[0].Regions) != 0 {
+		t.Errorf("Assign() on empty set = %v", got)
+	}
+}
+
+func TestAssignCapacity(t *testing.T) {
+	set := Set{
+		{Code: "fra", Provider: "test", Latitude: 50.1, Longitude: 8.7},
+		{Code: "dub", Provider: "test", Latitude: 53.3, Longitude: -6.3},
+	}
+	points := Population{
+		{Label: "Frankfurt", Latitude: 50.1, Longitude: 8.7, Weight: 3},
+		{Label: "Cologne", Latitude: 50.9, Longitude: 7.0, Weight: 2},
+		{Label: "Paris", Latitude: 48.9, Longitude: 2.4, Weight: 2},
+	}
+	capacity := map[RegionID]float64{"test/fra": 5, "test/dub": 2}
+
+	table := Assign(points, set, AssignOptions{Capacity: capacity})
+	got := map[string]RegionID{}
+	for _, a := range table {
+		got[a.Point.Label] = a.Regions[0]
+		if a.Overflow {
+			t.Errorf("Assign() overflow for %s", a.Point.Label)
+		}
+	}
+	want := map[string]RegionID{"Frankfurt": "test/fra", "Cologne": "test/fra", "Paris": "test/dub"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Assign() with capacity = %v, want %v", got, want)
+	}
+	if table[2].Regions[1] != "test/fra" {
+		t.Errorf("Assign() Paris failover = %v, want test/fra", table[2].Regions)
+	}
+
+	capacity["test/dub"] = 1
+	table = Assign(points, set, AssignOptions{Capacity: capacity})
+	if !table[2].Overflow || table[2].Regions[0] != "test/fra" {
+		t.Errorf("Assign() without room = %+v, want overflow to test/fra", table[2])
+	}
+}
+
+func TestCountryRoutingTable(t *testing.T) {
+	points, err := CountryPoints(map[string]float64{"de": 1, "JP": 1, "BR": 1})
+	if err != nil {
+		t.Fatalf("CountryPoints() error = %v", err)
+	}
+	if points[0].Label != "BR" || points[1].Label != "DE" || points[2].Label != "JP" {
+		t.Errorf("CountryPoints() labels = %v", points)
+	}
+	if _, err := CountryPoints(map[string]float64{"XX": 1}); err == nil {
+		t.Error("CountryPoints(XX) succeeded, want error")
+	}
+
+	table := Assign(points, OnProvider("aws"), AssignOptions{})
+	var buf bytes.Buffer
+	if err := table.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	var routes map[string][]RegionID
+	if err := json.Unmarshal(buf.Bytes(), &routes); err != nil {
+		t.Fatalf("WriteJSON() output is not JSON: %v", err)
+	}
+	for country, want := range map[string]RegionID{"BR": "aws/sa-east-1", "DE": "aws/eu-central-1", "JP": "aws/ap-northeast-1"} {
+		if got := routes[country]; len(got) != 3 || got[0] != want {
+			t.Errorf("routes[%s] = %v, want %s first", country, got, want)
+		}
+	}
+
+	buf.Reset()
+	if err := table.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != "point,primary,failover_1,failover_2" || len(lines) != 4 {
+		t.Errorf("WriteCSV() = %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[2], "DE,aws/eu-central-1,") {
+		t.Errorf("WriteCSV() DE row = %q", lines[2])
+	}
+}
@@ -0,0 +1,314 @@
+package where
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Match is a search hit: a region and how well it matched the query.
+type Match struct {
+	Region Region  `json:"region"`
+	Score  float64 `json:"score"` // 1 for an exact match, lower for fuzzier ones
+	Field  string  `json:"field"` // field that matched best: code, name, city, country or alias
+}
+
+// minSearchScore is the lowest score Search reports.
+const minSearchScore = 0.5
+
+// Search answers "where is something like {text}?" - ranks regions by how well
+// their code, name, city, country or aliases match the text. Matching ignores
+// case, accents and punctuation, accepts misspellings and matches by token,
+// so "us-east1", "Frankfurt am Main" and "Sao Paulo" all find regions.
+func Search(text string) []Match {
+	return search(catalog, text)
+}
+
+// search ranks the regions of the set against the text.
+func search(regions Set, text string) []Match {
+	query := normalizeText(text)
+	if query == "" {
+		return nil
+	}
+
+	matches := make([]Match, 0)
+	for _, region := range regions {
+		if m, ok := matchRegion(region, query); ok {
+			matches = append(matches, m)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// Suggest returns up to n regions that best match the text, for "did you mean" hints.
+func Suggest(text string, n int) Set {
+	return suggest(catalog, text, n)
+}
+
+// suggest returns up to n regions of the set that best match the text.
+func suggest(regions Set, text string, n int) Set {
+	matches := search(regions, text)
+	if len(matches) > n {
+		matches = matches[:n]
+	}
+	suggestions := make(Set, len(matches))
+	for i, m := range matches {
+		suggestions[i] = m.Region
+	}
+	return suggestions
+}
+
+// NotFoundError reports an unknown region code; Suggestions lists the closest
+// known regions. It matches ErrRegionNotFound with errors.Is.
+type NotFoundError struct {
+	Code     Code
+	Provider string // set when the lookup was restricted to one provider
+
+	once        sync.Once
+	suggestions Set
+}
+
+// maxSuggestions is the number of suggestions attached to a NotFoundError.
+const maxSuggestions = 3
+
+// newNotFoundError builds a NotFoundError for the code. Suggestions are
+// searched for on first use, so callers that only test for a miss stay cheap.
+func newNotFoundError(code Code, provider string) *NotFoundError {
+	return &NotFoundError{Code: code, Provider: provider}
+}
+
+// Suggestions returns up to three regions resembling the code, restricted to
+// the provider of the lookup if any.
+func (e *NotFoundError) Suggestions() Set {
+	e.once.Do(func() {
+		candidates := catalog
+		if e.Provider != "" {
+			candidates = candidates.OnProvider(e.Provider)
+		}
+		e.suggestions = suggest(candidates, string(e.Code), maxSuggestions)
+	})
+	return e.suggestions
+}
+
+// Error returns the message, with "did you mean" hints when there are any.
+func (e *NotFoundError) Error() string {
+	msg := fmt.Sprintf("%v: %q", ErrRegionNotFound, e.Code)
+	if e.Provider != "" {
+		msg += " for provider " + e.Provider
+	}
+	suggestions := e.Suggestions()
+	if len(suggestions) == 0 {
+		return msg
+	}
+	hints := make([]string, len(suggestions))
+	for i, r := range suggestions {
+		hints[i] = string(r.ID())
+	}
+	return msg + " (did you mean " + strings.Join(hints, ", ") + "?)"
+}
+
+// Is reports whether target is ErrRegionNotFound.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrRegionNotFound
+}
+
+// cityAliases maps alternative city names to the names used in the catalog.
+var cityAliases = map[string][]string{
+	"frankfurt":      {"frankfurt am main", "ffm"},
+	"new york":       {"nyc", "new york city"},
+	"san francisco":  {"sf", "bay area"},
+	"silicon valley": {"bay area", "san jose"},
+	"ashburn":        {"n virginia", "northern virginia"},
+	"mumbai":         {"bombay"},
+	"chennai":        {"madras"},
+	"bangalore":      {"bengaluru"},
+	"delhi":          {"new delhi"},
+	"beijing":        {"peking"},
+	"sao paulo":      {"sampa"},
+	"munich":         {"münchen"},
+	"milan":          {"milano"},
+	"turin":          {"torino"},
+	"warsaw":         {"warszawa"},
+	"dublin":         {"baile atha cliath"},
+	"hohhot":         {"huhehaote"},
+	"ulanqab":        {"wulanchabu"},
+}
+
+// countryAliases maps alternative country names to the names used in the catalog.
+var countryAliases = map[string][]string{
+	"united states":        {"usa", "us", "america"},
+	"united kingdom":       {"uk", "great britain", "england"},
+	"united arab emirates": {"uae", "emirates"},
+	"south korea":          {"korea", "republic of korea"},
+	"germany":              {"deutschland"},
+	"netherlands":          {"holland", "the netherlands"},
+	"switzerland":          {"schweiz", "suisse"},
+	"china":                {"prc", "mainland china"},
+	"japan":                {"nippon"},
+	"spain":                {"espana"},
+	"brazil":               {"brasil"},
+	"czech republic":       {"czechia"},
+	"turkey":               {"turkiye"},
+}
+
+// aliasesFor returns the aliases of a region's city and country.
+func aliasesFor(r Region) []string {
+	aliases := append([]string{}, cityAliases[normalizeText(r.City)]...)
+	return append(aliases, countryAliases[normalizeText(r.Country)]...)
+}
+
+// matchRegion scores a region against a normalized query.
+func matchRegion(r Region, query string) (Match, bool) {
+	best := Match{Region: r}
+	try := func(field, value string, weight float64) {
+		if score := weight * matchScore(query, normalizeText(value)); score > best.Score {
+			best.Score, best.Field = score, field
+		}
+	}
+
+	try("code", string(r.Code), 1)
+	if compact(query) == compact(normalizeText(string(r.Code))) {
+		best.Score, best.Field = 1, "code"
+	}
+	try("name", r.Name, 0.95)
+	try("city", r.City, 0.9)
+	try("country", r.Country, 0.85)
+	for _, alias := range aliasesFor(r) {
+		try("alias", alias, 0.85)
+	}
+	return best, best.Score >= minSearchScore
+}
+
+// matchScore rates how well query matches value, from 0 to 1.
+func matchScore(query, value string) float64 {
+	if value == "" {
+		return 0
+	}
+	if query == value {
+		return 1
+	}
+
+	score := 0.0
+	valueTokens := strings.Fields(value)
+	queryTokens := strings.Fields(query)
+	found := 0
+	for _, q := range queryTokens {
+		for _, v := range valueTokens {
+			if q == v || (len(q) > 3 && similarity(q, v) >= 0.8) {
+				found++
+				break
+			}
+		}
+	}
+	if found > 0 {
+		// All query tokens present, e.g. "tokyo" in "asia pacific tokyo",
+		// or all value tokens present, e.g. "frankfurt" in "frankfurt am main".
+		if found == len(queryTokens) {
+			score = 0.8
+		} else if found == len(valueTokens) {
+			score = 0.75
+		} else {
+			score = 0.5 * float64(found) / float64(len(queryTokens))
+		}
+	}
+	if strings.HasPrefix(value, query) && len(query) >= 3 {
+		score = max(score, 0.7)
+	}
+	return max(score, similarity(query, value)*0.9)
+}
+
+// similarity returns 1 minus the normalized edit distance between a and b.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the edit distance between two rune slices.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// accentFolds maps accented Latin letters to their unaccented forms.
+var accentFolds = map[rune]string{
+	'á': "a", 'à': "a", 'â': "a", 'ä': "a", 'ã': "a", 'å': "a", 'ă': "a", 'ą': "a",
+	'é': "e", 'è': "e", 'ê': "e", 'ë': "e", 'ę': "e", 'ě': "e",
+	'í': "i", 'ì': "i", 'î': "i", 'ï': "i", 'ı': "i",
+	'ó': "o", 'ò': "o", 'ô': "o", 'ö': "o", 'õ': "o", 'ø': "o", 'ő': "o",
+	'ú': "u", 'ù': "u", 'û': "u", 'ü': "u", 'ű': "u", 'ů': "u",
+	'ç': "c", 'č': "c", 'ć': "c", 'ñ': "n", 'ń': "n", 'ý': "y", 'ÿ': "y",
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ł': "l", 'ś': "s", 'š': "s", 'ș': "s", 'ş': "s",
+	'ź': "z", 'ż': "z", 'ž': "z", 'ț': "t", 'ţ': "t", 'ğ': "g", 'ř': "r", 'đ': "d",
+}
+
+// normalizeText lowercases s, folds accents and turns punctuation into
+// single spaces, so "São Paulo" and "sao-paulo" normalize to "sao paulo".
+func normalizeText(s string) string {
+	var b strings.Builder
+	space := true
+	for _, r := range strings.ToLower(s) {
+		if folded, ok := accentFolds[r]; ok {
+			b.WriteString(folded)
+			space = false
+			continue
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		if !space {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// compact removes the spaces from a normalized string, so region codes match
+// regardless of separators ("us east1" and "us-east-1" both become "useast1").
+func compact(s string) string {
+	return strings.ReplaceAll(s, " ", "")
+}
+
+// sameName reports whether two place names refer to the same place, ignoring
+// case and accents and honouring known city and country aliases.
+func sameName(a, b string) bool {
+	na, nb := normalizeText(a), normalizeText(b)
+	if na == nb {
+		return true
+	}
+	for _, aliases := range []map[string][]string{cityAliases, countryAliases} {
+		for _, alias := range aliases[nb] {
+			if normalizeText(alias) == na {
+				return true
+			}
+		}
+	}
+	return false
+}
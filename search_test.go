@@ -0,0 +1,122 @@
+package where
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected RegionID
+	}{
+		{"us-east-1", "aws/us-east-1"},
+		{"useast1", "aws/us-east-1"},
+		{"Frankfurt am Main", "aws/eu-central-1"},
+		{"Sao Paulo", "aws/sa-east-1"},
+		{"São Paulo", "aws/sa-east-1"},
+		{"Tokio", "aws/ap-northeast-1"},
+		{"europe-wst1", "gcp/europe-west1"},
+		{"Bengaluru", "digitalocean/blr1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			matches := Search(tt.text)
+			if len(matches) == 0 {
+				t.Fatal("Search() returned no matches")
+			}
+			for i := 1; i < len(matches); i++ {
+				if matches[i].Score > matches[i-1].Score {
+					t.Fatal("Search() results should be sorted by score")
+				}
+			}
+			for _, m := range matches {
+				if m.Region.ID() == tt.expected {
+					if m.Score < 0.7 {
+						t.Errorf("Search(%q) scored %v at %.2f, want >= 0.7", tt.text, tt.expected, m.Score)
+					}
+					return
+				}
+			}
+			t.Errorf("Search(%q) did not find %v", tt.text, tt.expected)
+		})
+	}
+
+	if len(Search("")) != 0 {
+		t.Error("Search(\"\") should return no matches")
+	}
+	if len(Search("qwxzptk")) != 0 {
+		t.Error("Search() should not match gibberish")
+	}
+}
+
+func TestNotFoundSuggestions(t *testing.T) {
+	_, err := Is("us-est-1").First()
+	if !errors.Is(err, ErrRegionNotFound) {
+		t.Fatalf("expected ErrRegionNotFound, got %v", err)
+	}
+
+	var nf *NotFoundError
+	if !errors.As(err, &nf) {
+		t.Fatalf("expected *NotFoundError, got %T", err)
+	}
+	if len(nf.Suggestions()) == 0 || len(nf.Suggestions()) > maxSuggestions {
+		t.Fatalf("expected 1-%d suggestions, got %d", maxSuggestions, len(nf.Suggestions()))
+	}
+	if !strings.Contains(err.Error(), "did you mean") || !strings.Contains(err.Error(), "aws/us-east-1") {
+		t.Errorf("expected a did-you-mean hint for aws/us-east-1, got %q", err.Error())
+	}
+
+	_, err = Is("europe-west1").OnAWS()
+	if !errors.As(err, &nf) {
+		t.Fatalf("expected *NotFoundError, got %T", err)
+	}
+	for _, r := range nf.Suggestions() {
+		if r.Provider != ProviderAWS {
+			t.Errorf("provider-scoped suggestions should be AWS only, got %v", r.ID())
+		}
+	}
+
+	// Other providers' regions must not crowd out the provider's own.
+	_, err = Is("us-east-1").OnProvider(ProviderAzure)
+	if !errors.As(err, &nf) || len(nf.Suggestions()) == 0 || nf.Suggestions()[0].Code != "eastus" {
+		t.Errorf("Is(us-east-1).OnProvider(azure) error = %v, want eastus suggested first", err)
+	}
+
+	_, err = Are("us-east-1", "eu-wset-1", "nowhere")
+	if !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("Are() should return ErrRegionNotFound, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "eu-west-1") {
+		t.Errorf("Are() error should suggest eu-west-1, got %q", err.Error())
+	}
+}
+
+func TestAliasAwareFilters(t *testing.T) {
+	if len(InCity("Frankfurt am Main")) != len(InCity("Frankfurt")) {
+		t.Error("InCity() should resolve city aliases")
+	}
+	if len(InCity("Sao Paulo")) == 0 {
+		t.Error("InCity() should ignore accents")
+	}
+	if len(InCountry("USA")) != len(InCountry("United States")) {
+		t.Error("InCountry() should resolve country aliases")
+	}
+}
+
+func TestNormalizeText(t *testing.T) {
+	tests := map[string]string{
+		"São Paulo":          "sao paulo",
+		"  Zürich--Nord ":    "zurich nord",
+		"US East (Ohio)":     "us east ohio",
+		"Gävle":              "gavle",
+		"Kraków, Małopolska": "krakow malopolska",
+	}
+	for in, want := range tests {
+		if got := normalizeText(in); got != want {
+			t.Errorf("normalizeText(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package where
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// OpenTelemetry resource attribute keys for cloud resources.
+const (
+	AttrCloudProvider         = "cloud.provider"
+	AttrCloudRegion           = "cloud.region"
+	AttrCloudAvailabilityZone = "cloud.availability_zone"
+	AttrCloudPlatform         = "cloud.platform"
+)
+
+// Kubernetes well-known node labels for topology.
+const (
+	LabelTopologyRegion = "topology.kubernetes.io/region"
+	LabelTopologyZone   = "topology.kubernetes.io/zone"
+
+	// Deprecated labels still set by older clusters; only read, never written.
+	labelLegacyRegion = "failure-domain.beta.kubernetes.io/region"
+	labelLegacyZone   = "failure-domain.beta.kubernetes.io/zone"
+)
+
+// otelProviders maps provider names to the cloud.provider values defined by
+// the OpenTelemetry semantic conventions. Providers the conventions do not
+// list keep their own name.
+var otelProviders = map[string]string{
+	ProviderAWS:     "aws",
+	ProviderAzure:   "azure",
+	ProviderGCP:     "gcp",
+	ProviderAlibaba: "alibaba_cloud",
+	ProviderTencent: "tencent_cloud",
+}
+
+// OTelProvider returns the cloud.provider value for a provider name, e.g.
+// "alibaba_cloud" for ProviderAlibaba.
+func OTelProvider(provider string) string {
+	provider = strings.ToLower(provider)
+	if value, ok := otelProviders[provider]; ok {
+		return value
+	}
+	return provider
+}
+
+// ProviderFromOTel returns the provider name for a cloud.provider value; see
+// OTelProvider. Plain provider names are accepted as well.
+func ProviderFromOTel(value string) (string, error) {
+	value = strings.ToLower(value)
+	for provider, v := range otelProviders {
+		if v == value {
+			return provider, nil
+		}
+	}
+	for _, provider := range Providers() {
+		if provider == value {
+			return provider, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrProviderNotFound, value)
+}
+
+// OTelAttributes returns the OpenTelemetry resource attributes describing the
+// region. platform is the cloud.platform value, e.g. "aws_eks" or
+// "gcp_cloud_run", and is left out when empty.
+func (r Region) OTelAttributes(platform string) map[string]string {
+	attrs := map[string]string{
+		AttrCloudProvider: OTelProvider(r.Provider),
+		AttrCloudRegion:   string(r.Code),
+	}
+	if platform != "" {
+		attrs[AttrCloudPlatform] = platform
+	}
+	return attrs
+}
+
+// OTelAttributes returns the OpenTelemetry resource attributes describing the
+// zone, including cloud.availability_zone; see Region.OTelAttributes.
+func (z Zone) OTelAttributes(platform string) map[string]string {
+	attrs := map[string]string{
+		AttrCloudProvider:         OTelProvider(z.Provider),
+		AttrCloudRegion:           string(z.Region),
+		AttrCloudAvailabilityZone: z.Name,
+	}
+	if platform != "" {
+		attrs[AttrCloudPlatform] = platform
+	}
+	return attrs
+}
+
+// KubernetesLabels returns the topology labels of nodes in the region.
+func (r Region) KubernetesLabels() map[string]string {
+	return map[string]string{LabelTopologyRegion: string(r.Code)}
+}
+
+// KubernetesLabels returns the topology labels of nodes in the zone.
+func (z Zone) KubernetesLabels() map[string]string {
+	return map[string]string{
+		LabelTopologyRegion: string(z.Region),
+		LabelTopologyZone:   z.Name,
+	}
+}
+
+// FromOTelAttributes answers "where does this resource run?" - resolves the
+// region from OpenTelemetry resource attributes. cloud.region is required;
+// cloud.provider and cloud.availability_zone pick the provider when several
+// share the region code.
+func FromOTelAttributes(attrs map[string]string) (Region, error) {
+	code := attrs[AttrCloudRegion]
+	if code == "" {
+		return Region{}, fmt.Errorf("%w: no %s attribute", ErrRegionNotFound, AttrCloudRegion)
+	}
+	var provider string
+	if value := attrs[AttrCloudProvider]; value != "" {
+		var err error
+		if provider, err = ProviderFromOTel(value); err != nil {
+			return Region{}, err
+		}
+	}
+	return resolveRegion(provider, Code(code), attrs[AttrCloudAvailabilityZone])
+}
+
+// nodeLabelProviders maps label prefixes set by managed Kubernetes services
+// to their provider.
+var nodeLabelProviders = map[string]string{
+	"eks.amazonaws.com/":     ProviderAWS,
+	"k8s.amazonaws.com/":     ProviderAWS,
+	"cloud.google.com/":      ProviderGCP,
+	"kubernetes.azure.com/":  ProviderAzure,
+	"alibabacloud.com/":      ProviderAlibaba,
+	"doks.digitalocean.com/": ProviderDigitalOcean,
+	"lke.linode.com/":        ProviderLinode,
+}
+
+// FromKubernetesLabels answers "where does this node run?" - resolves the
+// region from a node's topology labels, falling back to the deprecated
+// failure-domain labels. The zone label and labels set by managed services
+// (EKS, GKE, AKS, ...) pick the provider when several share the region code.
+func FromKubernetesLabels(labels map[string]string) (Region, error) {
+	code := labels[LabelTopologyRegion]
+	if code == "" {
+		code = labels[labelLegacyRegion]
+	}
+	if code == "" {
+		return Region{}, fmt.Errorf("%w: no %s label", ErrRegionNotFound, LabelTopologyRegion)
+	}
+	zone := labels[LabelTopologyZone]
+	if zone == "" {
+		zone = labels[labelLegacyZone]
+	}
+	return resolveRegion(labelProvider(labels), Code(code), zone)
+}
+
+// labelProvider returns the provider named by the first label key, in sorted
+// order, carrying a managed-service prefix, or "" if none does.
+func labelProvider(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for prefix, provider := range nodeLabelProviders {
+			if strings.HasPrefix(key, prefix) {
+				return provider
+			}
+		}
+	}
+	return ""
+}
+
+// resolveRegion looks up a region code, restricted to a provider when one is
+// known. Otherwise the zone picks the provider if only one has a zone of that
+// name in the region, and the first region with the code is returned as a
+// last resort.
+func resolveRegion(provider string, code Code, zone string) (Region, error) {
+	if provider != "" {
+		return Is(code).OnProvider(provider)
+	}
+	candidates := Is(code).All()
+	if zone != "" && len(candidates) > 1 {
+		var matches Set
+		for _, r := range candidates {
+			if z, ok := findZone(r.Provider, zone); ok && z.Region == code {
+				matches = append(matches, r)
+			}
+		}
+		if len(matches) == 1 {
+			return matches[0], nil
+		}
+	}
+	return Is(code).First()
+}
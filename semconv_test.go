@@ -0,0 +1,116 @@
+package where
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOTelProvider(t *testing.T) {
+	tests := []struct {
+		provider string
+		want     string
+	}{
+		{ProviderAWS, "aws"},
+		{ProviderAzure, "azure"},
+		{ProviderGCP, "gcp"},
+		{ProviderAlibaba, "alibaba_cloud"},
+		{ProviderTencent, "tencent_cloud"},
+		{ProviderFly, "fly"},
+	}
+	for _, tt := range tests {
+		if got := OTelProvider(tt.provider); got != tt.want {
+			t.Errorf("OTelProvider(%q) = %q, want %q", tt.provider, got, tt.want)
+		}
+		if got, err := ProviderFromOTel(tt.want); err != nil || got != tt.provider {
+			t.Errorf("ProviderFromOTel(%q) = %q, %v, want %q", tt.want, got, err, tt.provider)
+		}
+	}
+	if _, err := ProviderFromOTel("heroku"); !errors.Is(err, ErrProviderNotFound) {
+		t.Errorf("ProviderFromOTel(heroku) error = %v, want ErrProviderNotFound", err)
+	}
+}
+
+func TestOTelAttributes(t *testing.T) {
+	region := MustIs("cn-hangzhou")
+	want := map[string]string{
+		AttrCloudProvider: "alibaba_cloud",
+		AttrCloudRegion:   "cn-hangzhou",
+		AttrCloudPlatform: "alibaba_cloud_ecs",
+	}
+	if got := region.OTelAttributes("alibaba_cloud_ecs"); !reflect.DeepEqual(got, want) {
+		t.Errorf("OTelAttributes() = %v, want %v", got, want)
+	}
+
+	zone, err := FindZone("us-central1-a")
+	if err != nil {
+		t.Fatalf("FindZone() error = %v", err)
+	}
+	attrs := zone.OTelAttributes("")
+	if attrs[AttrCloudAvailabilityZone] != "us-central1-a" || attrs[AttrCloudProvider] != "gcp" {
+		t.Errorf("Zone.OTelAttributes() = %v", attrs)
+	}
+	if _, ok := attrs[AttrCloudPlatform]; ok {
+		t.Errorf("Zone.OTelAttributes(\"\") sets %s", AttrCloudPlatform)
+	}
+}
+
+func TestFromOTelAttributes(t *testing.T) {
+	tests := []struct {
+		attrs map[string]string
+		want  RegionID
+	}{
+		{map[string]string{AttrCloudProvider: "alibaba_cloud", AttrCloudRegion: "us-east-1"}, "alibaba/us-east-1"},
+		{map[string]string{AttrCloudProvider: "aws", AttrCloudRegion: "us-east-1"}, "aws/us-east-1"},
+		{map[string]string{AttrCloudRegion: "europe-west1"}, "gcp/europe-west1"},
+	}
+	for _, tt := range tests {
+		got, err := FromOTelAttributes(tt.attrs)
+		if err != nil || got.ID() != tt.want {
+			t.Errorf("FromOTelAttributes(%v) = %v, %v, want %v", tt.attrs, got.ID(), err, tt.want)
+		}
+	}
+	if _, err := FromOTelAttributes(map[string]string{AttrCloudProvider: "aws"}); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("FromOTelAttributes(no region) error = %v, want ErrRegionNotFound", err)
+	}
+	if _, err := FromOTelAttributes(map[string]string{AttrCloudProvider: "gcp", AttrCloudRegion: "us-east-1"}); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("FromOTelAttributes(gcp us-east-1) error = %v, want ErrRegionNotFound", err)
+	}
+}
+
+func TestKubernetesLabels(t *testing.T) {
+	zone, err := FindZone("swedencentral-2")
+	if err != nil {
+		t.Fatalf("FindZone() error = %v", err)
+	}
+	want := map[string]string{LabelTopologyRegion: "swedencentral", LabelTopologyZone: "swedencentral-2"}
+	if got := zone.KubernetesLabels(); !reflect.DeepEqual(got, want) {
+		t.Errorf("KubernetesLabels() = %v, want %v", got, want)
+	}
+	if got, err := FromKubernetesLabels(zone.KubernetesLabels()); err != nil || got.ID() != "azure/swedencentral" {
+		t.Errorf("FromKubernetesLabels(%v) = %v, %v", want, got.ID(), err)
+	}
+}
+
+func TestFromKubernetesLabels(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   RegionID
+	}{
+		{"zone only on aws", map[string]string{LabelTopologyRegion: "us-east-1", LabelTopologyZone: "us-east-1f"}, "aws/us-east-1"},
+		{"managed service label", map[string]string{LabelTopologyRegion: "us-east-1", "alibabacloud.com/nodepool-id": "np1"}, "alibaba/us-east-1"},
+		{"eks label", map[string]string{LabelTopologyRegion: "me-central-1", "eks.amazonaws.com/nodegroup": "ng"}, "aws/me-central-1"},
+		{"legacy labels", map[string]string{labelLegacyRegion: "europe-north1", labelLegacyZone: "europe-north1-a"}, "gcp/europe-north1"},
+		{"two managed labels", map[string]string{LabelTopologyRegion: "us-east-1", "eks.amazonaws.com/nodegroup": "ng", "kubernetes.azure.com/agentpool": "ap"}, "aws/us-east-1"},
+	}
+	for _, tt := range tests {
+		got, err := FromKubernetesLabels(tt.labels)
+		if err != nil || got.ID() != tt.want {
+			t.Errorf("FromKubernetesLabels(%s) = %v, %v, want %v", tt.name, got.ID(), err, tt.want)
+		}
+	}
+	if _, err := FromKubernetesLabels(map[string]string{"kubernetes.io/os": "linux"}); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("FromKubernetesLabels(no region) error = %v, want ErrRegionNotFound", err)
+	}
+}
@@ -0,0 +1,252 @@
+package where
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ServiceMatrix records which services are available in which regions.
+// A matrix is immutable once built and safe for concurrent use.
+type ServiceMatrix struct {
+	regions map[RegionID]map[string]bool
+}
+
+// NewServiceMatrix builds a matrix from a map of region IDs to the services
+// available there. Service names are case-insensitive.
+func NewServiceMatrix(available map[RegionID][]string) *ServiceMatrix {
+	m := &ServiceMatrix{regions: make(map[RegionID]map[string]bool, len(available))}
+	for id, services := range available {
+		set := make(map[string]bool, len(services))
+		for _, service := range services {
+			set[normalizeService(service)] = true
+		}
+		m.regions[id] = set
+	}
+	return m
+}
+
+// ReadServiceMatrix reads a matrix from JSON mapping region IDs to service
+// names, e.g. {"aws/us-east-1": ["ec2", "bedrock"]}.
+func ReadServiceMatrix(r io.Reader) (*ServiceMatrix, error) {
+	m := &ServiceMatrix{}
+	if err := json.NewDecoder(r).Decode(m); err != nil {
+		return nil, fmt.Errorf("read service matrix: %w", err)
+	}
+	return m, nil
+}
+
+// LoadServiceMatrix reads a matrix from a JSON file; see ReadServiceMatrix.
+func LoadServiceMatrix(path string) (*ServiceMatrix, error) {
+	return loadFile(path, ReadServiceMatrix)
+}
+
+// Supports reports whether the service is available in the region.
+func (m *ServiceMatrix) Supports(id RegionID, service string) bool {
+	return m.regions[id][normalizeService(service)]
+}
+
+// Services returns the sorted names of the services available in the region.
+func (m *ServiceMatrix) Services(id RegionID) []string {
+	services := make([]string, 0, len(m.regions[id]))
+	for service := range m.regions[id] {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// Regions returns the sorted IDs of the regions offering the service.
+func (m *ServiceMatrix) Regions(service string) []RegionID {
+	service = normalizeService(service)
+	ids := make([]RegionID, 0)
+	for id, services := range m.regions {
+		if services[service] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// MarshalJSON encodes the matrix in the format read by ReadServiceMatrix.
+func (m *ServiceMatrix) MarshalJSON() ([]byte, error) {
+	out := make(map[RegionID][]string, len(m.regions))
+	for id := range m.regions {
+		out[id] = m.Services(id)
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a matrix in the format read by ReadServiceMatrix.
+func (m *ServiceMatrix) UnmarshalJSON(data []byte) error {
+	available, err := readRegionMap[[]string](bytes.NewReader(data), nil)
+	if err != nil {
+		return err
+	}
+	*m = *NewServiceMatrix(available)
+	return nil
+}
+
+// serviceData is the matrix consulted by Supports and WithService.
+var serviceData = dataset[ServiceMatrix]{baseline: baselineServices}
+
+// UseServiceMatrix replaces the matrix consulted by Region.Supports,
+// Set.WithService and Query.WithService. Passing nil restores the baseline
+// shipped with the package.
+func UseServiceMatrix(m *ServiceMatrix) {
+	serviceData.use(m)
+}
+
+// CurrentServiceMatrix returns the matrix in use, the baseline unless
+// UseServiceMatrix installed another one.
+func CurrentServiceMatrix() *ServiceMatrix {
+	return serviceData.current()
+}
+
+// Supports reports whether the region belongs to the provider and offers the
+// service according to the current service matrix.
+func (r Region) Supports(provider, service string) bool {
+	return r.Provider == strings.ToLower(provider) && CurrentServiceMatrix().Supports(r.ID(), service)
+}
+
+// Services returns the services available in the region according to the
+// current service matrix.
+func (r Region) Services() []string {
+	return CurrentServiceMatrix().Services(r.ID())
+}
+
+// WithService filters regions of the provider that offer the service.
+func (s Set) WithService(provider, service string) Set {
+	matrix := CurrentServiceMatrix()
+	provider = strings.ToLower(provider)
+	return s.Filter(func(r Region) bool {
+		return r.Provider == provider && matrix.Supports(r.ID(), service)
+	})
+}
+
+// CommonServices returns the sorted names of the services available in every
+// region of the set. Service names are provider-specific, so sets spanning
+// several providers rarely share any.
+func (s Set) CommonServices() []string {
+	if len(s) == 0 {
+		return nil
+	}
+	matrix := CurrentServiceMatrix()
+	common := make([]string, 0)
+	for _, service := range matrix.Services(s[0].ID()) {
+		shared := true
+		for _, r := range s[1:] {
+			if !matrix.Supports(r.ID(), service) {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			common = append(common, service)
+		}
+	}
+	return common
+}
+
+// normalizeService lowercases and trims a service name.
+func normalizeService(service string) string {
+	return strings.ToLower(strings.TrimSpace(service))
+}
+
+// serviceFootprints is the baseline service data: each entry lists the
+// regions of a provider that offer a service, or every running catalog
+// region of the provider when Regions is nil; see runsServices. It covers
+// widely used services only and lags provider rollouts; load a current export
+// for deploy-time decisions.
+var serviceFootprints = []struct {
+	Provider string
+	Service  string
+	Regions  []Code
+}{
+	// AWS
+	{ProviderAWS, "ec2", nil},
+	{ProviderAWS, "s3", nil},
+	{ProviderAWS, "lambda", nil},
+	{ProviderAWS, "dynamodb", nil},
+	{ProviderAWS, "rds", nil},
+	{ProviderAWS, "sqs", nil},
+	{ProviderAWS, "eks", nil},
+	{ProviderAWS, "bedrock", []Code{"us-east-1", "us-east-2", "us-west-2", "us-gov-west-1", "ca-central-1", "sa-east-1", "eu-central-1", "eu-west-1", "eu-west-2", "eu-west-3", "eu-north-1", "ap-northeast-1", "ap-northeast-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2"}},
+	{ProviderAWS, "sagemaker", []Code{"us-east-1", "us-east-2", "us-west-1", "us-west-2", "us-gov-west-1", "ca-central-1", "sa-east-1", "eu-central-1", "eu-west-1", "eu-west-2", "eu-west-3", "eu-north-1", "eu-south-1", "af-south-1", "ap-east-1", "ap-south-1", "ap-northeast-1", "ap-northeast-2", "ap-northeast-3", "ap-southeast-1", "ap-southeast-2", "me-south-1", "cn-north-1", "cn-northwest-1"}},
+
+	// Azure
+	{ProviderAzure, "virtualmachines", nil},
+	{ProviderAzure, "storage", nil},
+	{ProviderAzure, "sqldatabase", nil},
+	{ProviderAzure, "cosmosdb", nil},
+	{ProviderAzure, "aks", nil},
+	{ProviderAzure, "keyvault", nil},
+	{ProviderAzure, "openai", []Code{"eastus", "eastus2", "northcentralus", "southcentralus", "westus", "westus3", "canadaeast", "brazilsouth", "swedencentral", "switzerlandnorth", "francecentral", "germanywestcentral", "norwayeast", "polandcentral", "spaincentral", "uksouth", "japaneast", "koreacentral", "australiaeast", "southindia", "southafricanorth", "uaenorth"}},
+
+	// Google Cloud
+	{ProviderGCP, "compute", nil},
+	{ProviderGCP, "storage", nil},
+	{ProviderGCP, "gke", nil},
+	{ProviderGCP, "run", nil},
+	{ProviderGCP, "pubsub", nil},
+	{ProviderGCP, "cloudsql", nil},
+	{ProviderGCP, "aiplatform", []Code{"us-central1", "us-east1", "us-east4", "us-east5", "us-south1", "us-west1", "us-west4", "northamerica-northeast1", "southamerica-east1", "europe-west1", "europe-west2", "europe-west3", "europe-west4", "europe-west6", "europe-west8", "europe-west9", "europe-north1", "europe-central2", "europe-southwest1", "asia-east1", "asia-east2", "asia-northeast1", "asia-northeast3", "asia-south1", "asia-southeast1", "australia-southeast1", "me-central1", "me-west1"}},
+
+	// Alibaba Cloud, Tencent Cloud and Huawei Cloud
+	{ProviderAlibaba, "ecs", nil},
+	{ProviderAlibaba, "oss", nil},
+	{ProviderAlibaba, "ack", nil},
+	{ProviderTencent, "cvm", nil},
+	{ProviderTencent, "cos", nil},
+	{ProviderTencent, "tke", nil},
+	{ProviderHuawei, "ecs", nil},
+	{ProviderHuawei, "obs", nil},
+	{ProviderHuawei, "cce", nil},
+
+	// Yandex Cloud
+	{ProviderYandex, "compute", nil},
+	{ProviderYandex, "storage", nil},
+	{ProviderYandex, "kubernetes", nil},
+
+	// Developer clouds
+	{ProviderDigitalOcean, "droplets", nil},
+	{ProviderDigitalOcean, "kubernetes", nil},
+	{ProviderDigitalOcean, "spaces", []Code{"nyc3", "sfo2", "sfo3", "ams3", "fra1", "sgp1", "syd1", "blr1", "atl1"}},
+	{ProviderLinode, "linodes", nil},
+	{ProviderLinode, "lke", nil},
+	{ProviderVultr, "compute", nil},
+	{ProviderVultr, "kubernetes", nil},
+	{ProviderFly, "machines", nil},
+}
+
+// baselineServices is the matrix shipped with the package, built from
+// serviceFootprints.
+var baselineServices = func() *ServiceMatrix {
+	available := make(map[RegionID][]string)
+	for _, f := range serviceFootprints {
+		if f.Regions == nil {
+			for _, r := range catalog.OnProvider(f.Provider) {
+				if runsServices(r) {
+					available[r.ID()] = append(available[r.ID()], f.Service)
+				}
+			}
+			continue
+		}
+		for _, code := range f.Regions {
+			id := NewRegionID(f.Provider, code)
+			available[id] = append(available[id], f.Service)
+		}
+	}
+	return NewServiceMatrix(available)
+}()
+
+// runsServices reports whether a region hosts workloads at all: announced
+// regions have not launched and retired ones have shut down, so neither
+// offers the provider-wide services of the baseline.
+func runsServices(r Region) bool {
+	return r.Status != Announced && r.Status != Retired
+}
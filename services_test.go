@@ -0,0 +1,109 @@
+package where
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestBaselineServices(t *testing.T) {
+	for _, f := range serviceFootprints {
+		for _, code := range f.Regions {
+			if _, err := ByID(NewRegionID(f.Provider, code)); err != nil {
+				t.Errorf("service %s/%s lists unknown region %q", f.Provider, f.Service, code)
+			}
+		}
+	}
+
+	if !MustIs("us-east-1").Supports("aws", "bedrock") {
+		t.Error("us-east-1 should support bedrock")
+	}
+	if MustIs("us-east-1").Supports("azure", "bedrock") {
+		t.Error("Supports() should be false for another provider")
+	}
+	if !MustIs("swedencentral").Supports("Azure", "CosmosDB") {
+		t.Error("Supports() should ignore case")
+	}
+	if MustIs("af-south-1").Supports("aws", "bedrock") {
+		t.Error("af-south-1 should not support bedrock in the baseline")
+	}
+	for _, r := range catalog {
+		if (r.Status == Retired || r.Status == Announced) && len(r.Services()) > 0 {
+			t.Errorf("%s is %v but offers %v", r.ID(), r.Status, r.Services())
+		}
+	}
+	if retired, _ := ByID("azure/chinaeast"); retired.Supports(ProviderAzure, "aks") {
+		t.Error("retired azure/chinaeast should not offer aks")
+	}
+}
+
+func TestWithService(t *testing.T) {
+	regions := NewQuery().WithService("aws", "bedrock").Exec()
+	if len(regions) == 0 {
+		t.Fatal("WithService() returned no regions")
+	}
+	for _, r := range regions {
+		if r.Provider != ProviderAWS {
+			t.Errorf("WithService() returned %v", r.ID())
+		}
+	}
+
+	common := InCountry("Germany").OnProvider("aws").CommonServices()
+	if !containsString(common, "ec2") {
+		t.Errorf("CommonServices() = %v, want ec2 included", common)
+	}
+	if got := (Set{}).CommonServices(); got != nil {
+		t.Errorf("CommonServices() on empty set = %v, want nil", got)
+	}
+}
+
+func TestUseServiceMatrix(t *testing.T) {
+	defer UseServiceMatrix(nil)
+
+	data := `{"aws/eu-west-1": ["EC2", "bedrock"], "aws/eu-west-2": ["ec2"]}`
+	matrix, err := ReadServiceMatrix(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ReadServiceMatrix() failed: %v", err)
+	}
+	UseServiceMatrix(matrix)
+
+	got := NewQuery().WithService("aws", "bedrock").Codes()
+	if len(got) != 1 || got[0] != "eu-west-1" {
+		t.Errorf("WithService() = %v, want [eu-west-1]", got)
+	}
+	common := Set{MustIs("eu-west-1"), MustIs("eu-west-2")}.CommonServices()
+	if len(common) != 1 || common[0] != "ec2" {
+		t.Errorf("CommonServices() = %v, want [ec2]", common)
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(matrix); err != nil {
+		t.Fatalf("encode failed: %v", err)
+	}
+	again, err := ReadServiceMatrix(&buf)
+	if err != nil {
+		t.Fatalf("ReadServiceMatrix() round trip failed: %v", err)
+	}
+	if got := again.Services("aws/eu-west-1"); len(got) != 2 || got[0] != "bedrock" {
+		t.Errorf("Services() after round trip = %v, want [bedrock ec2]", got)
+	}
+
+	UseServiceMatrix(nil)
+	if CurrentServiceMatrix() != baselineServices {
+		t.Error("UseServiceMatrix(nil) should restore the baseline")
+	}
+
+	if _, err := ReadServiceMatrix(strings.NewReader(`{"us-east-1": ["ec2"]}`)); err == nil {
+		t.Error("ReadServiceMatrix() should reject IDs without a provider")
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
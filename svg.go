@@ -0,0 +1,283 @@
+package where
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// MapColoring selects what region markers on an SVG map are colored by.
+type MapColoring uint8
+
+const (
+	// ColorByProvider colors regions by their provider.
+	ColorByProvider MapColoring = iota
+	// ColorByStatus colors regions by their status.
+	ColorByStatus
+)
+
+// Link connects two regions on a map with a great-circle arc, such as a
+// replication link.
+type Link struct {
+	From RegionID
+	To   RegionID
+}
+
+// MapOptions configures WriteSVG. The zero value renders a 1200x600 map
+// colored by provider.
+type MapOptions struct {
+	Width   int // in pixels; the height is half the width
+	Title   string
+	ColorBy MapColoring
+	Links   []Link
+}
+
+// providerColors are the marker colors of each provider's brand.
+var providerColors = map[string]string{
+	ProviderAWS:          "#ff9900",
+	ProviderAzure:        "#0078d4",
+	ProviderGCP:          "#34a853",
+	ProviderAlibaba:      "#ff6a00",
+	ProviderYandex:       "#fc3f1d",
+	ProviderDigitalOcean: "#0080ff",
+	ProviderLinode:       "#02b159",
+	ProviderVultr:        "#5d3fd3",
+	ProviderFly:          "#7b3be2",
+	ProviderTencent:      "#00a4ff",
+	ProviderHuawei:       "#c7000b",
+}
+
+// statusColors are the marker colors of each status.
+var statusColors = map[Status]string{
+	Active:     "#2e7d32",
+	Deprecated: "#ef6c00",
+	Preview:    "#1565c0",
+	OptIn:      "#00897b",
+	Restricted: "#6a1b9a",
+	Announced:  "#9e9e9e",
+	Retired:    "#c62828",
+}
+
+// defaultMarkerColor is used for providers without a brand color.
+const defaultMarkerColor = "#607d8b"
+
+// WriteSVG renders the set on an equirectangular world map as a standalone
+// SVG image, with a legend and optional great-circle links between regions.
+// Link endpoints are looked up in the set first, then in the catalog.
+func (s Set) WriteSVG(w io.Writer, opts MapOptions) error {
+	width := float64(opts.Width)
+	if width <= 0 {
+		width = 1200
+	}
+	height := width / 2
+	project := func(lat, lng float64) (float64, float64) {
+		return (lng + 180) / 360 * width, (90 - lat) / 180 * height
+	}
+
+	links := make([][2]Region, len(opts.Links))
+	for i, link := range opts.Links {
+		from, err := s.lookupID(link.From)
+		if err != nil {
+			return err
+		}
+		to, err := s.lookupID(link.To)
+		if err != nil {
+			return err
+		}
+		links[i] = [2]Region{from, to}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%g" viewBox="0 0 %g %g">`+"\n", width, height, width, height)
+	if opts.Title != "" {
+		fmt.Fprintf(bw, "<title>%s</title>\n", html.EscapeString(opts.Title))
+	}
+	fmt.Fprintf(bw, `<rect width="%g" height="%g" fill="#eef4f8"/>`+"\n", width, height)
+
+	// Graticule every 30 degrees.
+	fmt.Fprint(bw, `<g stroke="#d5e0e8" stroke-width="0.5">`+"\n")
+	for lng := -150.0; lng < 180; lng += 30 {
+		x, _ := project(0, lng)
+		fmt.Fprintf(bw, `<line x1="%.1f" y1="0" x2="%.1f" y2="%g"/>`+"\n", x, x, height)
+	}
+	for lat := -60.0; lat <= 60; lat += 30 {
+		_, y := project(lat, 0)
+		fmt.Fprintf(bw, `<line x1="0" y1="%.1f" x2="%g" y2="%.1f"/>`+"\n", y, width, y)
+	}
+	fmt.Fprint(bw, "</g>\n")
+
+	fmt.Fprint(bw, `<g fill="#cfd8dc" stroke="#b0bec5" stroke-width="0.5">`+"\n")
+	for _, outline := range worldOutlines {
+		points := make([]string, len(outline))
+		for i, p := range outline {
+			x, y := project(p[1], p[0])
+			points[i] = fmt.Sprintf("%.1f,%.1f", x, y)
+		}
+		fmt.Fprintf(bw, `<polygon points="%s"/>`+"\n", strings.Join(points, " "))
+	}
+	fmt.Fprint(bw, "</g>\n")
+
+	if len(links) > 0 {
+		fmt.Fprint(bw, `<g fill="none" stroke="#37474f" stroke-width="1.2" stroke-opacity="0.7">`+"\n")
+		for _, link := range links {
+			from, to := link[0], link[1]
+			arc := greatCircle(from.Latitude, from.Longitude, to.Latitude, to.Longitude, 64)
+			for _, segment := range splitAntimeridian(arc) {
+				points := make([]string, len(segment))
+				for i, p := range segment {
+					x, y := project(p[0], p[1])
+					points[i] = fmt.Sprintf("%.1f,%.1f", x, y)
+				}
+				fmt.Fprintf(bw, `<polyline points="%s"><title>%s - %s</title></polyline>`+"\n",
+					strings.Join(points, " "), from.ID(), to.ID())
+			}
+		}
+		fmt.Fprint(bw, "</g>\n")
+	}
+
+	legend := make(map[string]string)
+	fmt.Fprint(bw, `<g stroke="#ffffff" stroke-width="0.8">`+"\n")
+	for _, r := range s {
+		label, color := markerStyle(r, opts.ColorBy)
+		legend[label] = color
+		x, y := project(r.Latitude, r.Longitude)
+		fmt.Fprintf(bw, `<circle cx="%.1f" cy="%.1f" r="4" fill="%s"><title>%s - %s</title></circle>`+"\n",
+			x, y, color, r.ID(), html.EscapeString(r.Name))
+	}
+	fmt.Fprint(bw, "</g>\n")
+
+	labels := make([]string, 0, len(legend))
+	for label := range legend {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	fmt.Fprint(bw, `<g font-family="sans-serif" font-size="12" fill="#263238">`+"\n")
+	for i, label := range labels {
+		y := height - 12 - float64(len(labels)-1-i)*16
+		fmt.Fprintf(bw, `<circle cx="16" cy="%.1f" r="5" fill="%s"/><text x="26" y="%.1f">%s</text>`+"\n",
+			y, legend[label], y+4, html.EscapeString(label))
+	}
+	fmt.Fprint(bw, "</g>\n</svg>\n")
+	return bw.Flush()
+}
+
+// markerStyle returns the legend label and color of a region's marker.
+func markerStyle(r Region, coloring MapColoring) (string, string) {
+	if coloring == ColorByStatus {
+		if color, ok := statusColors[r.Status]; ok {
+			return r.Status.String(), color
+		}
+		return r.Status.String(), defaultMarkerColor
+	}
+	if color, ok := providerColors[r.Provider]; ok {
+		return r.Provider, color
+	}
+	return r.Provider, defaultMarkerColor
+}
+
+// lookupID finds a region by ID in the set, falling back to the catalog.
+func (s Set) lookupID(id RegionID) (Region, error) {
+	for _, r := range s {
+		if r.ID() == id {
+			return r, nil
+		}
+	}
+	return ByID(id)
+}
+
+// splitAntimeridian splits a path of [latitude, longitude] points where it
+// crosses the antimeridian, so that no segment wraps across the whole map.
+func splitAntimeridian(path [][2]float64) [][][2]float64 {
+	var segments [][][2]float64
+	start := 0
+	for i := 1; i < len(path); i++ {
+		if math.Abs(path[i][1]-path[i-1][1]) > 180 {
+			segments = append(segments, path[start:i])
+			start = i
+		}
+	}
+	return append(segments, path[start:])
+}
+
+// worldOutlines are coarse land outlines as [longitude, latitude] points,
+// enough to orient a reader on a region map.
+var worldOutlines = [][][2]float64{
+	// North America
+	{{-168, 66}, {-162, 70}, {-156, 71.3}, {-141, 69.6}, {-128, 70}, {-115, 68.5}, {-95, 72}, {-82, 73},
+		{-80, 63}, {-94, 59}, {-92, 57}, {-82, 55}, {-79, 51.5}, {-77, 60}, {-70, 61}, {-64, 60}, {-61, 56},
+		{-56, 52}, {-60, 47.5}, {-66, 45}, {-70, 43.5}, {-70, 41.7}, {-74, 40.5}, {-76, 37}, {-75.5, 35.2},
+		{-81, 31.5}, {-80, 27}, {-80.3, 25.3}, {-82, 26.5}, {-83, 29.5}, {-89, 30.2}, {-94, 29.5}, {-97.5, 26},
+		{-97.5, 22}, {-96, 19}, {-94.5, 18.2}, {-91, 19}, {-90.5, 21}, {-87, 21.5}, {-88, 16}, {-83.5, 15},
+		{-83.5, 11}, {-79, 9}, {-77.5, 8.5}, {-79.5, 7.5}, {-82, 8.3}, {-85.7, 10.5}, {-87.5, 13}, {-91.5, 14},
+		{-96, 15.7}, {-105.5, 20}, {-110, 23.5}, {-114.5, 30}, {-117, 32.5}, {-120.5, 34.5}, {-124, 40},
+		{-124, 46}, {-124.7, 48.4}, {-128, 51}, {-133, 55}, {-140, 59.8}, {-147, 60.8}, {-152, 59}, {-158, 58},
+		{-165, 54.5}, {-158, 56.5}, {-162, 59}, {-165, 62}, {-165, 64.5}},
+	// Greenland
+	{{-73, 78}, {-60, 82}, {-30, 83.5}, {-12, 81.5}, {-19, 74}, {-22, 70}, {-32, 68}, {-40, 65}, {-43.5, 60},
+		{-50, 62}, {-53.5, 66}, {-54, 71}, {-58, 75.5}, {-68, 76.5}},
+	// South America
+	{{-77.5, 8.5}, {-72, 12}, {-62, 10.7}, {-51, 4}, {-50, 0}, {-44, -2.5}, {-35, -5.5}, {-35, -9}, {-39, -13.5},
+		{-39, -18}, {-41, -22}, {-48, -25.5}, {-48.5, -28.5}, {-53, -33.7}, {-58, -34.5}, {-57, -38}, {-62, -39},
+		{-65, -42}, {-65.5, -47}, {-69, -51}, {-68.5, -54.5}, {-71, -54}, {-75, -50}, {-73.5, -43}, {-73.5, -37},
+		{-71.5, -30}, {-70.3, -18.3}, {-75.5, -15}, {-79.5, -7}, {-81, -5}, {-80, -2}, {-80.5, 1}, {-78, 3},
+		{-77.5, 7}},
+	// Eurasia
+	{{-9, 38.7}, {-9, 43}, {-1.5, 43.5}, {-1.5, 46.5}, {-4.5, 48.5}, {-1.5, 49.5}, {2, 51}, {4.5, 52.5},
+		{8.5, 53.5}, {8.5, 57}, {10.5, 57.5}, {10.5, 54.5}, {13, 54.5}, {19, 54.5}, {21, 57}, {24, 57.5},
+		{24, 59.5}, {30, 60}, {22.5, 60.5}, {21.5, 63.5}, {25, 65.5}, {21.5, 65.5}, {17, 62}, {19, 59.5},
+		{16.5, 56.3}, {12.5, 56}, {11, 58.5}, {8, 58}, {5, 59}, {5, 62}, {13, 66}, {17, 69}, {25, 71}, {31, 70},
+		{41, 67}, {44, 68.5}, {54, 68.5}, {60, 69}, {69, 73}, {80, 73}, {87, 75}, {100, 77.5}, {113, 73.5},
+		{130, 71}, {140, 72}, {160, 70}, {170, 70}, {180, 68.5}, {180, 65}, {173, 61}, {163, 60}, {156, 51},
+		{156.5, 57.5}, {152, 59}, {142, 59}, {136, 54.5}, {141, 52}, {140, 48}, {135, 43.5}, {131, 42.5},
+		{129, 41}, {129.5, 35.5}, {126.5, 34.5}, {126, 37.5}, {125, 39.5}, {121.5, 39}, {121, 41}, {118, 39},
+		{122, 37}, {119.5, 35}, {122, 31}, {121.5, 28}, {117.5, 24}, {113, 22}, {109.5, 21.5}, {108, 21.5},
+		{106.5, 20}, {106, 18}, {109, 15}, {109, 11.5}, {105, 8.6}, {104.8, 10.5}, {100.5, 13.5}, {99.5, 10},
+		{101, 7}, {103.5, 1.3}, {101.5, 2.8}, {98.5, 8}, {98.2, 13}, {97.5, 16.5}, {94.2, 16}, {92.5, 20.5},
+		{91.5, 22.5}, {88, 21.6}, {86.5, 20}, {80.2, 15.5}, {80, 10}, {77.5, 8}, {76, 10}, {73, 17}, {72.6, 21},
+		{70, 22.5}, {67, 24.5}, {61.5, 25.2}, {57.5, 25.5}, {56.4, 26.4}, {56.2, 25.5}, {58.5, 23.6},
+		{59.8, 22.5}, {57.8, 19}, {55, 17}, {52, 15.5}, {45, 12.8}, {43.3, 12.7}, {42.5, 15.5}, {39, 21.5},
+		{35, 28}, {34.2, 31.3}, {35, 33}, {36, 36}, {32, 36.2}, {27, 37}, {26.5, 40.5}, {23, 40.5}, {22.5, 37},
+		{21, 38.5}, {19.5, 41.5}, {13.5, 45.5}, {12.3, 45}, {14, 42}, {16, 41}, {18.5, 40}, {16.5, 38.5},
+		{15.6, 38}, {16, 40}, {15, 40.2}, {12, 41.8}, {10, 44}, {7.5, 43.8}, {3, 43.2}, {3.2, 42}, {0.5, 40.5},
+		{-0.5, 38.5}, {-2, 36.7}, {-5.6, 36}, {-7, 37.2}, {-9, 37}},
+	// Chukotka, east of the antimeridian
+	{{-180, 68.5}, {-174, 67}, {-169.7, 66}, {-172.5, 64.3}, {-180, 65}},
+	// Africa
+	{{-17, 21}, {-17, 14.7}, {-15, 11}, {-13, 8.5}, {-11, 6.8}, {-7.5, 4.4}, {-2, 4.8}, {2, 6.3}, {5, 5.8},
+		{6.5, 4.3}, {8.5, 4.5}, {9.7, 3}, {9.5, -1}, {12, -5}, {13.5, -11}, {12, -17}, {14.5, -22.5},
+		{16.5, -28.6}, {18.4, -34}, {20, -34.8}, {25.5, -34}, {30, -31.3}, {32.5, -28.5}, {32.9, -26},
+		{35.5, -24}, {35.3, -21.5}, {34.7, -19.7}, {40, -16}, {40.5, -10.5}, {39.3, -7}, {39.2, -4.7},
+		{41.5, -1.7}, {44, 1.5}, {49, 6}, {51.3, 11.8}, {44, 10.5}, {43.2, 11.5}, {41.5, 13.8}, {38.5, 18},
+		{37.2, 21.5}, {35.6, 24}, {34, 27}, {32.5, 30}, {32, 31.2}, {29, 30.9}, {25, 31.7}, {20, 31.7},
+		{19.5, 30.3}, {16, 31.2}, {11, 33.2}, {10.2, 36.8}, {9.5, 37.3}, {3, 36.8}, {-1.5, 35.2}, {-5.9, 35.8},
+		{-6.8, 34}, {-9.6, 30.4}, {-13.2, 27.6}},
+	// Madagascar
+	{{49.3, -12}, {50.4, -15.5}, {49.5, -18}, {47.2, -25}, {45, -25.5}, {43.3, -22}, {44.4, -16.2}, {47, -15}},
+	// Great Britain and Ireland
+	{{-5.7, 50}, {1.4, 51.2}, {1.7, 52.7}, {0, 53.5}, {-1.5, 55.5}, {-2, 57.5}, {-3, 58.6}, {-5, 58.6},
+		{-6.2, 56.7}, {-5, 55}, {-3, 54}, {-4.7, 52.8}, {-5.2, 51.7}},
+	{{-6, 52}, {-6, 55.2}, {-8.3, 55.2}, {-10, 53.5}, {-10.4, 51.8}, {-8, 51.5}},
+	// Japan
+	{{130, 31.3}, {131.8, 33.9}, {135, 33.5}, {137, 34.5}, {140, 35}, {141, 38}, {141.5, 41.5}, {140.2, 41.4},
+		{140, 39.5}, {139.5, 38}, {136.8, 37.2}, {136, 35.7}, {133, 35.5}, {130.9, 34}},
+	{{140, 41.5}, {141.5, 42.5}, {143.5, 42}, {145.5, 43.3}, {142, 45.5}, {139.8, 42.5}},
+	// Sumatra, Java and Borneo
+	{{95.3, 5.6}, {98, 4}, {104, -1.5}, {106, -5.8}, {104.5, -5.9}, {100.3, -2.2}},
+	{{105.2, -6.8}, {108.6, -6.7}, {114.5, -7.8}, {114.4, -8.7}, {108, -7.8}},
+	{{109, 1.5}, {110.3, -1.5}, {114.5, -4}, {116.5, -2}, {118.5, 1}, {117.6, 4.2}, {119, 5.2}, {117, 7},
+		{115.4, 5.2}, {113, 3.1}, {111, 1.6}},
+	// Australia
+	{{113.5, -22}, {114, -26.5}, {115, -34}, {117.8, -35}, {123.5, -33.9}, {131, -31.5}, {135.5, -34.7},
+		{138, -35.6}, {140, -37.9}, {144, -38.4}, {146.5, -39}, {150, -37.5}, {151.2, -33.8}, {153.5, -28.5},
+		{153, -25}, {149, -21}, {145.5, -15}, {143.5, -14}, {142.5, -10.7}, {141.5, -13}, {141.5, -16.8},
+		{139.5, -17.5}, {136.5, -15.5}, {137, -12.2}, {132.5, -11.5}, {130, -13}, {129, -15}, {126, -14},
+		{122, -17.5}, {121, -19.5}, {117, -20.7}},
+	// New Zealand
+	{{172.6, -34.4}, {174.5, -36.5}, {178.5, -37.7}, {176.9, -39.6}, {175, -41.5}, {174.6, -39.8}},
+	{{174.3, -41.1}, {173.9, -42.5}, {172.7, -43.7}, {171, -44.9}, {169, -46.6}, {166.5, -46}, {168.3, -44},
+		{172, -41}},
+}
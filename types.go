@@ -10,6 +10,27 @@ import (
 // Code represents a strongly-typed region identifier.
 type Code string
 
+// RegionID is a provider-qualified region identifier in the form "provider/code",
+// e.g. "aws/ap-southeast-1". Unlike Code, it is unique across providers.
+type RegionID string
+
+// NewRegionID builds a RegionID from a provider name and region code.
+func NewRegionID(provider string, code Code) RegionID {
+	return RegionID(strings.ToLower(provider) + "/" + string(code))
+}
+
+// Provider returns the provider part of the identifier.
+func (id RegionID) Provider() string {
+	provider, _, _ := strings.Cut(string(id), "/")
+	return provider
+}
+
+// Code returns the region code part of the identifier.
+func (id RegionID) Code() Code {
+	_, code, _ := strings.Cut(string(id), "/")
+	return Code(code)
+}
+
 // Status represents the operational status of a cloud region.
 type Status uint8
 
@@ -20,20 +41,51 @@ const (
 	Deprecated
 	// Preview indicates the region has limited availability and may not have all services.
 	Preview
+	// OptIn indicates the region is generally available but disabled until an
+	// account opts in, like AWS af-south-1.
+	OptIn
+	// Restricted indicates the region is available only to approved customers
+	// or through a local partner, like Azure's restricted regions.
+	Restricted
+	// Announced indicates the region has been announced but has not launched.
+	Announced
+	// Retired indicates the region has been shut down.
+	Retired
 )
 
 // String returns the human-readable status name.
 func (s Status) String() string {
-	switch s {
-	case Active:
-		return "active"
-	case Deprecated:
-		return "deprecated"
-	case Preview:
-		return "preview"
-	default:
-		return "unknown"
+	if name, ok := statusNames[s]; ok {
+		return name
 	}
+	return "unknown"
+}
+
+// Partition identifies the cloud environment a region belongs to. Regions in
+// isolated partitions (government, sovereign, China) need separate accounts
+// and endpoints and are not reachable from the commercial cloud.
+type Partition string
+
+const (
+	// PartitionCommercial is the public commercial cloud of a provider.
+	PartitionCommercial Partition = "commercial"
+	// PartitionAWSGovCloud is AWS GovCloud (US).
+	PartitionAWSGovCloud Partition = "aws-us-gov"
+	// PartitionAWSChina is AWS China, operated by local partners.
+	PartitionAWSChina Partition = "aws-cn"
+	// PartitionAWSEuropeanSovereign is the AWS European Sovereign Cloud.
+	PartitionAWSEuropeanSovereign Partition = "aws-eusc"
+	// PartitionAzureGovernment is Azure Government (US), including the DoD regions.
+	PartitionAzureGovernment Partition = "azure-us-government"
+	// PartitionAzureChina is Azure China, operated by 21Vianet.
+	PartitionAzureChina Partition = "azure-china"
+	// PartitionGCPSovereign covers Google Cloud sovereign offerings run by local partners.
+	PartitionGCPSovereign Partition = "gcp-sovereign"
+)
+
+// IsIsolated returns true if the partition is separate from the commercial cloud.
+func (p Partition) IsIsolated() bool {
+	return p != PartitionCommercial && p != ""
 }
 
 // Region represents a cloud provider region with comprehensive metadata.
@@ -49,6 +101,13 @@ type Region struct {
 	Status     Status    `json:"status"`
 	LaunchDate time.Time `json:"launch_date"`
 	Zones      []string  `json:"zones"`
+	Partition  Partition `json:"partition"`
+	Lifecycle  Lifecycle `json:"lifecycle"`
+}
+
+// ID returns the provider-qualified identifier of the region.
+func (r Region) ID() RegionID {
+	return NewRegionID(r.Provider, r.Code)
 }
 
 // Distance calculates the great-circle distance to another region in kilometers.
@@ -56,9 +115,15 @@ func (r Region) Distance(other Region) float64 {
 	return haversineDistance(r.Latitude, r.Longitude, other.Latitude, other.Longitude)
 }
 
-// IsActive returns true if the region is currently active and available.
+// IsActive returns true if the region is currently active and available,
+// including opt-in and restricted regions that an account must be enabled for.
 func (r Region) IsActive() bool {
-	return r.Status == Active
+	return r.Status == Active || r.Status == OptIn || r.Status == Restricted
+}
+
+// IsIsolated returns true if the region belongs to an isolated partition.
+func (r Region) IsIsolated() bool {
+	return r.Partition.IsIsolated()
 }
 
 // IsNear returns true if the region is within the specified radius (km) of the target location.
@@ -87,17 +152,19 @@ func (s Set) OnProvider(name string) Set {
 	})
 }
 
-// ByCountry filters regions by country name.
+// ByCountry filters regions by country name, ignoring case and accents and
+// accepting common aliases such as "USA" or "UK".
 func (s Set) ByCountry(name string) Set {
 	return s.Filter(func(r Region) bool {
-		return strings.EqualFold(r.Country, name)
+		return sameName(name, r.Country)
 	})
 }
 
-// ByCity filters regions by city name.
+// ByCity filters regions by city name, ignoring case and accents and
+// accepting common aliases such as "Frankfurt am Main".
 func (s Set) ByCity(name string) Set {
 	return s.Filter(func(r Region) bool {
-		return strings.EqualFold(r.City, name)
+		return sameName(name, r.City)
 	})
 }
 
@@ -111,7 +178,26 @@ func (s Set) ByContinent(name string) Set {
 // ActiveOnly filters to only active regions.
 func (s Set) ActiveOnly() Set {
 	return s.Filter(func(r Region) bool {
-		return r.Status == Active
+		return r.IsActive()
+	})
+}
+
+// InPartition filters regions belonging to any of the given partitions.
+func (s Set) InPartition(partitions ...Partition) Set {
+	return s.Filter(func(r Region) bool {
+		for _, p := range partitions {
+			if r.Partition == p {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// CommercialOnly filters out regions from isolated partitions.
+func (s Set) CommercialOnly() Set {
+	return s.Filter(func(r Region) bool {
+		return !r.IsIsolated()
 	})
 }
 
@@ -139,21 +225,23 @@ func (s Set) Last() (Region, error) {
 }
 
 // Union returns a new set containing all regions from both sets (no duplicates).
+// Regions are compared by provider and code, so same-code regions of different
+// providers are kept apart.
 func (s Set) Union(other Set) Set {
-	seen := make(map[Code]bool)
+	seen := make(map[RegionID]bool)
 	result := make(Set, 0, len(s)+len(other))
 
 	for _, region := range s {
-		if !seen[region.Code] {
+		if !seen[region.ID()] {
 			result = append(result, region)
-			seen[region.Code] = true
+			seen[region.ID()] = true
 		}
 	}
 
 	for _, region := range other {
-		if !seen[region.Code] {
+		if !seen[region.ID()] {
 			result = append(result, region)
-			seen[region.Code] = true
+			seen[region.ID()] = true
 		}
 	}
 
@@ -162,14 +250,14 @@ func (s Set) Union(other Set) Set {
 
 // Intersect returns a new set containing only regions present in both sets.
 func (s Set) Intersect(other Set) Set {
-	otherMap := make(map[Code]bool)
+	otherMap := make(map[RegionID]bool)
 	for _, region := range other {
-		otherMap[region.Code] = true
+		otherMap[region.ID()] = true
 	}
 
 	result := make(Set, 0)
 	for _, region := range s {
-		if otherMap[region.Code] {
+		if otherMap[region.ID()] {
 			result = append(result, region)
 		}
 	}
@@ -179,14 +267,14 @@ func (s Set) Intersect(other Set) Set {
 
 // Difference returns a new set containing regions in this set but not in the other.
 func (s Set) Difference(other Set) Set {
-	otherMap := make(map[Code]bool)
+	otherMap := make(map[RegionID]bool)
 	for _, region := range other {
-		otherMap[region.Code] = true
+		otherMap[region.ID()] = true
 	}
 
 	result := make(Set, 0)
 	for _, region := range s {
-		if !otherMap[region.Code] {
+		if !otherMap[region.ID()] {
 			result = append(result, region)
 		}
 	}
@@ -274,6 +362,7 @@ func haversineDistance(lat1, lng1, lat2, lng2 float64) float64 {
 // RegionQuery allows filtering regions by provider after lookup by code.
 // This enables patterns like: where.Is("us-east-1").OnAWS()
 type RegionQuery struct {
+	code    Code
 	regions []Region
 }
 
@@ -302,6 +391,36 @@ func (rq RegionQuery) OnYandex() (Region, error) {
 	return rq.OnProvider("yandex")
 }
 
+// OnDigitalOcean filters the query results to only DigitalOcean regions.
+func (rq RegionQuery) OnDigitalOcean() (Region, error) {
+	return rq.OnProvider("digitalocean")
+}
+
+// OnLinode filters the query results to only Akamai Cloud (Linode) regions.
+func (rq RegionQuery) OnLinode() (Region, error) {
+	return rq.OnProvider("linode")
+}
+
+// OnVultr filters the query results to only Vultr regions.
+func (rq RegionQuery) OnVultr() (Region, error) {
+	return rq.OnProvider("vultr")
+}
+
+// OnFly filters the query results to only Fly.io regions.
+func (rq RegionQuery) OnFly() (Region, error) {
+	return rq.OnProvider("fly")
+}
+
+// OnTencent filters the query results to only Tencent Cloud regions.
+func (rq RegionQuery) OnTencent() (Region, error) {
+	return rq.OnProvider("tencent")
+}
+
+// OnHuawei filters the query results to only Huawei Cloud regions.
+func (rq RegionQuery) OnHuawei() (Region, error) {
+	return rq.OnProvider("huawei")
+}
+
 // OnProvider filters the query results to only regions from the specified provider.
 func (rq RegionQuery) OnProvider(provider string) (Region, error) {
 	for _, region := range rq.regions {
@@ -309,7 +428,7 @@ func (rq RegionQuery) OnProvider(provider string) (Region, error) {
 			return region, nil
 		}
 	}
-	return Region{}, fmt.Errorf("%w: no region found for provider %s", ErrRegionNotFound, provider)
+	return Region{}, newNotFoundError(rq.code, strings.ToLower(provider))
 }
 
 // All returns all matching regions for the code (useful when multiple providers have the same code).
@@ -320,7 +439,7 @@ func (rq RegionQuery) All() []Region {
 // First returns the first matching region.
 func (rq RegionQuery) First() (Region, error) {
 	if len(rq.regions) == 0 {
-		return Region{}, fmt.Errorf("%w", ErrRegionNotFound)
+		return Region{}, newNotFoundError(rq.code, "")
 	}
 	return rq.regions[0], nil
 }
@@ -305,6 +305,37 @@ func TestSet_Union(t *testing.T) {
 	}
 }
 
+func TestSet_UnionAcrossProviders(t *testing.T) {
+	set1 := Set{{Provider: "aws", Code: "ap-southeast-1"}}
+	set2 := Set{
+		{Provider: "huawei", Code: "ap-southeast-1"},
+		{Provider: "aws", Code: "ap-southeast-1"}, // Duplicate
+	}
+
+	union := set1.Union(set2)
+	if len(union) != 2 {
+		t.Errorf("Expected 2 regions in union, got %d", len(union))
+	}
+
+	diff := set2.Difference(set1)
+	if len(diff) != 1 || diff[0].Provider != "huawei" {
+		t.Errorf("Expected only the huawei region in difference, got %v", diff)
+	}
+}
+
+func TestRegionID(t *testing.T) {
+	id := Region{Provider: "aws", Code: "ap-southeast-1"}.ID()
+	if id != "aws/ap-southeast-1" {
+		t.Errorf("Region.ID() = %q, want %q", id, "aws/ap-southeast-1")
+	}
+	if id.Provider() != "aws" {
+		t.Errorf("RegionID.Provider() = %q, want %q", id.Provider(), "aws")
+	}
+	if id.Code() != "ap-southeast-1" {
+		t.Errorf("RegionID.Code() = %q, want %q", id.Code(), "ap-southeast-1")
+	}
+}
+
 func TestSet_Intersect(t *testing.T) {
 	set1 := Set{
 		{Code: "us-east-1"},
@@ -0,0 +1,150 @@
+package where
+
+import (
+	"database/sql/driver"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// ParseCode returns the code if it names a region in the catalog, in any
+// partition. Unknown codes fail with a *NotFoundError that matches
+// ErrRegionNotFound and carries suggestions.
+func ParseCode(s string) (Code, error) {
+	code := Code(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := regionRegistry[code]; !ok {
+		return "", newNotFoundError(Code(s), "")
+	}
+	return code, nil
+}
+
+// ParseRegionID returns the identifier if it names a region in the catalog,
+// e.g. "aws/eu-west-1". Unknown regions fail with a *NotFoundError that
+// matches ErrRegionNotFound and carries suggestions.
+func ParseRegionID(s string) (RegionID, error) {
+	s = strings.TrimSpace(s)
+	provider, code, ok := strings.Cut(s, "/")
+	if !ok || provider == "" || code == "" {
+		return "", fmt.Errorf("%w: invalid region ID %q, want \"provider/code\"", ErrRegionNotFound, s)
+	}
+	region, err := ByID(NewRegionID(provider, Code(strings.ToLower(code))))
+	if err != nil {
+		return "", err
+	}
+	return region.ID(), nil
+}
+
+// String returns the code as a string.
+func (c Code) String() string {
+	return string(c)
+}
+
+// Set parses and validates a code; it implements flag.Value.
+func (c *Code) Set(s string) error {
+	code, err := ParseCode(s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
+
+// MarshalText encodes the code as-is.
+func (c Code) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText decodes the code as-is, so that documents naming regions
+// outside the catalog round-trip; validate it with ParseCode where needed.
+func (c *Code) UnmarshalText(text []byte) error {
+	*c = Code(text)
+	return nil
+}
+
+// Scan reads and validates a code from a database column; it implements
+// sql.Scanner. Scan nullable columns into sql.NullString and use ParseCode.
+func (c *Code) Scan(src interface{}) error {
+	s, err := scanString(src, "Code")
+	if err != nil {
+		return err
+	}
+	return c.Set(s)
+}
+
+// Value stores the code as a string; it implements driver.Valuer.
+func (c Code) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// String returns the identifier as a string.
+func (id RegionID) String() string {
+	return string(id)
+}
+
+// Set parses and validates an identifier; it implements flag.Value.
+func (id *RegionID) Set(s string) error {
+	parsed, err := ParseRegionID(s)
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// MarshalText encodes the identifier as-is.
+func (id RegionID) MarshalText() ([]byte, error) {
+	return []byte(id), nil
+}
+
+// UnmarshalText decodes the identifier as-is; validate it with ParseRegionID
+// where needed.
+func (id *RegionID) UnmarshalText(text []byte) error {
+	*id = RegionID(text)
+	return nil
+}
+
+// Scan reads and validates an identifier from a database column; it
+// implements sql.Scanner. Scan nullable columns into sql.NullString and use
+// ParseRegionID.
+func (id *RegionID) Scan(src interface{}) error {
+	s, err := scanString(src, "RegionID")
+	if err != nil {
+		return err
+	}
+	return id.Set(s)
+}
+
+// Value stores the identifier as a string; it implements driver.Valuer.
+func (id RegionID) Value() (driver.Value, error) {
+	return string(id), nil
+}
+
+// scanString converts a database value to a string for Scan.
+func scanString(src interface{}, typ string) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case nil:
+		return "", errors.New("cannot scan NULL into " + typ)
+	default:
+		return "", fmt.Errorf("cannot scan %T into %s", src, typ)
+	}
+}
+
+// Flag defines a region code flag on fs, or on flag.CommandLine when fs is
+// nil, and returns a pointer to its value. Values are validated as the flags
+// are parsed:
+//
+//	region := where.Flag(nil, "region", "us-east-1", "region to deploy to")
+func Flag(fs *flag.FlagSet, name string, value Code, usage string) *Code {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	p := new(Code)
+	*p = value
+	fs.Var(p, name, usage)
+	return p
+}
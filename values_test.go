@@ -0,0 +1,158 @@
+package where
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"testing"
+)
+
+var (
+	_ flag.Value               = (*Code)(nil)
+	_ flag.Value               = (*RegionID)(nil)
+	_ sql.Scanner              = (*Code)(nil)
+	_ sql.Scanner              = (*RegionID)(nil)
+	_ driver.Valuer            = Code("")
+	_ driver.Valuer            = RegionID("")
+	_ encoding.TextUnmarshaler = (*Code)(nil)
+	_ encoding.TextUnmarshaler = (*RegionID)(nil)
+)
+
+func TestParseCode(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Code
+		ok   bool
+	}{
+		{"us-east-1", "us-east-1", true},
+		{" EU-West-1 ", "eu-west-1", true},
+		{"cn-north-1", "cn-north-1", true},
+		{"us-eest-1", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, err := ParseCode(tt.in)
+		if got != tt.want || (err == nil) != tt.ok {
+			t.Errorf("ParseCode(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+		if err != nil && !errors.Is(err, ErrRegionNotFound) {
+			t.Errorf("ParseCode(%q) error %v does not match ErrRegionNotFound", tt.in, err)
+		}
+	}
+
+	_, err := ParseCode("us-eest-1")
+	var nf *NotFoundError
+	if !errors.As(err, &nf) || len(nf.Suggestions()) == 0 || nf.Suggestions()[0].Code != "us-east-1" {
+		t.Errorf("ParseCode(us-eest-1) error = %v, want suggestion us-east-1", err)
+	}
+}
+
+func TestParseRegionID(t *testing.T) {
+	tests := []struct {
+		in   string
+		want RegionID
+		ok   bool
+	}{
+		{"aws/eu-west-1", "aws/eu-west-1", true},
+		{"GCP/europe-west1", "gcp/europe-west1", true},
+		{"gcp/eu-west-1", "", false},
+		{"eu-west-1", "", false},
+		{"aws/", "", false},
+	}
+	for _, tt := range tests {
+		got, err := ParseRegionID(tt.in)
+		if got != tt.want || (err == nil) != tt.ok {
+			t.Errorf("ParseRegionID(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+		if err != nil && !errors.Is(err, ErrRegionNotFound) {
+			t.Errorf("ParseRegionID(%q) error %v does not match ErrRegionNotFound", tt.in, err)
+		}
+	}
+}
+
+func TestFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	region := Flag(fs, "region", "us-east-1", "region to deploy to")
+	var id RegionID
+	fs.Var(&id, "id", "region ID")
+
+	if *region != "us-east-1" {
+		t.Errorf("Flag() default = %q, want us-east-1", *region)
+	}
+	if err := fs.Parse([]string{"-region", "eu-central-1", "-id", "azure/swedencentral"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if *region != "eu-central-1" || id != "azure/swedencentral" {
+		t.Errorf("Parse() = %q, %q, want eu-central-1, azure/swedencentral", *region, id)
+	}
+	if err := fs.Parse([]string{"-region", "mars-north-1"}); err == nil {
+		t.Error("Parse(-region mars-north-1) succeeded, want error")
+	}
+}
+
+func TestCodeDecoding(t *testing.T) {
+	var config struct {
+		Primary  Code       `json:"primary"`
+		Replicas []RegionID `json:"replicas"`
+	}
+	good := `{"primary": "eu-west-1", "replicas": ["aws/eu-central-1", "gcp/europe-west1"]}`
+	if err := json.Unmarshal([]byte(good), &config); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if config.Primary != "eu-west-1" || len(config.Replicas) != 2 {
+		t.Errorf("Unmarshal() = %+v", config)
+	}
+	// Decoding is lossless; validation is left to ParseCode and ParseRegionID.
+	unknown := `{"primary": "on-prem-1", "replicas": ["acme/on-prem-1"]}`
+	if err := json.Unmarshal([]byte(unknown), &config); err != nil {
+		t.Fatalf("Unmarshal(%s) error = %v", unknown, err)
+	}
+	if config.Primary != "on-prem-1" || config.Replicas[0] != "acme/on-prem-1" {
+		t.Errorf("Unmarshal(%s) = %+v", unknown, config)
+	}
+	if _, err := ParseCode(string(config.Primary)); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("ParseCode(%s) error = %v, want ErrRegionNotFound", config.Primary, err)
+	}
+
+	// Tables built over custom regions decode back.
+	custom := Set{{Code: "on-prem-1", Provider: "acme", Latitude: 50, Longitude: 8}}
+	data, err := json.Marshal(Assign(Population{{Label: "x", Weight: 1}}, custom, AssignOptions{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var table RoutingTable
+	if err := json.Unmarshal(data, &table); err != nil || table[0].Regions[0] != "acme/on-prem-1" {
+		t.Errorf("Unmarshal(RoutingTable) = %v, %v", table, err)
+	}
+
+	// Regions outside the catalog still decode as part of a Region.
+	var r Region
+	if err := json.Unmarshal([]byte(`{"code": "on-prem-1", "provider": "acme"}`), &r); err != nil || r.Code != "on-prem-1" {
+		t.Errorf("Unmarshal(custom region) = %v, %v", r.Code, err)
+	}
+}
+
+func TestCodeScan(t *testing.T) {
+	var c Code
+	if err := c.Scan([]byte("ap-northeast-1")); err != nil || c != "ap-northeast-1" {
+		t.Errorf("Scan([]byte) = %q, %v", c, err)
+	}
+	if err := c.Scan(nil); err == nil {
+		t.Error("Scan(nil) succeeded, want error")
+	}
+	if err := c.Scan(42); err == nil {
+		t.Error("Scan(42) succeeded, want error")
+	}
+	var id RegionID
+	if err := id.Scan("aws/ap-northeast-1"); err != nil || id != "aws/ap-northeast-1" {
+		t.Errorf("Scan(string) = %q, %v", id, err)
+	}
+	if v, err := id.Value(); err != nil || v != "aws/ap-northeast-1" {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+}
@@ -198,6 +198,46 @@ func TestProviderNamespaces(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("developer cloud providers", func(t *testing.T) {
+		tests := []struct {
+			provider string
+			code     where.Code
+			regions  where.Set
+		}{
+			{where.ProviderDigitalOcean, where.DigitalOcean.FRA1, where.On.DigitalOcean()},
+			{where.ProviderLinode, where.Linode.EUCentral, where.On.Linode()},
+			{where.ProviderVultr, where.Vultr.EWR, where.On.Vultr()},
+			{where.ProviderFly, where.Fly.CDG, where.On.Fly()},
+		}
+
+		for _, test := range tests {
+			if len(test.regions) == 0 {
+				t.Errorf("On.%s() returned no regions", test.provider)
+			}
+			region, err := where.Is(test.code).OnProvider(test.provider)
+			if err != nil {
+				t.Errorf("Is(%q).OnProvider(%q) failed: %v", test.code, test.provider, err)
+				continue
+			}
+			if region.Provider != test.provider {
+				t.Errorf("Expected provider %v, got %v", test.provider, region.Provider)
+			}
+		}
+	})
+
+	t.Run("nearest developer cloud region", func(t *testing.T) {
+		// Lyon, France
+		nearest := where.On.Fly().Near(45.7640, 4.8357, 1000)
+		nearest.SortByDistance(45.7640, 4.8357)
+		region, err := nearest.First()
+		if err != nil {
+			t.Fatalf("Expected a Fly.io region near Lyon: %v", err)
+		}
+		if region.Code != where.Fly.CDG {
+			t.Errorf("Expected %v to be nearest to Lyon, got %v", where.Fly.CDG, region.Code)
+		}
+	})
 }
 
 func TestRealWorldScenarios(t *testing.T) {
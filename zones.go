@@ -0,0 +1,288 @@
+package where
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ZoneKind classifies a zone by how it relates to its parent region.
+type ZoneKind uint8
+
+const (
+	// AvailabilityZone is a standard, isolated location inside a region.
+	AvailabilityZone ZoneKind = iota
+	// LocalZone extends a region into a metro area far from the region itself.
+	LocalZone
+	// WavelengthZone embeds compute in a telecom carrier's 5G network.
+	WavelengthZone
+)
+
+// String returns the human-readable zone kind.
+func (k ZoneKind) String() string {
+	if name, ok := zoneKindNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Zone represents a placement location that belongs to a parent region.
+// Availability zones share their region's coordinates; local and wavelength
+// zones carry the coordinates of the metro they serve.
+type Zone struct {
+	Name      string   `json:"name"`
+	Kind      ZoneKind `json:"kind"`
+	Provider  string   `json:"provider"`
+	Region    Code     `json:"region"`
+	Group     string   `json:"group"` // zone group / network border group
+	Country   string   `json:"country"`
+	City      string   `json:"city"`
+	Continent string   `json:"continent"`
+	Latitude  float64  `json:"latitude"`
+	Longitude float64  `json:"longitude"`
+	OptIn     bool     `json:"opt_in"` // must be enabled per account before use
+	Carrier   string   `json:"carrier,omitempty"`
+}
+
+// Distance calculates the great-circle distance to a region in kilometers.
+func (z Zone) Distance(r Region) float64 {
+	return haversineDistance(z.Latitude, z.Longitude, r.Latitude, r.Longitude)
+}
+
+// IsNear returns true if the zone is within the specified radius (km) of the target location.
+func (z Zone) IsNear(lat, lng float64, radiusKm float64) bool {
+	return haversineDistance(z.Latitude, z.Longitude, lat, lng) <= radiusKm
+}
+
+// ParentRegion returns the region the zone belongs to.
+func (z Zone) ParentRegion() (Region, error) {
+	return Is(z.Region).OnProvider(z.Provider)
+}
+
+// ZoneSet represents a collection of zones.
+type ZoneSet []Zone
+
+// Filter applies a predicate function to create a new filtered set.
+func (s ZoneSet) Filter(predicate func(Zone) bool) ZoneSet {
+	result := make(ZoneSet, 0, len(s))
+	for _, zone := range s {
+		if predicate(zone) {
+			result = append(result, zone)
+		}
+	}
+	return result
+}
+
+// ByKind filters zones by kind.
+func (s ZoneSet) ByKind(kind ZoneKind) ZoneSet {
+	return s.Filter(func(z Zone) bool {
+		return z.Kind == kind
+	})
+}
+
+// ByRegion filters zones by parent region code.
+func (s ZoneSet) ByRegion(code Code) ZoneSet {
+	return s.Filter(func(z Zone) bool {
+		return z.Region == code
+	})
+}
+
+// OnProvider filters zones by cloud provider name.
+func (s ZoneSet) OnProvider(name string) ZoneSet {
+	return s.Filter(func(z Zone) bool {
+		return strings.EqualFold(z.Provider, name)
+	})
+}
+
+// ByCountry filters zones by country name.
+func (s ZoneSet) ByCountry(name string) ZoneSet {
+	return s.Filter(func(z Zone) bool {
+		return strings.EqualFold(z.Country, name)
+	})
+}
+
+// ByCity filters zones by city name.
+func (s ZoneSet) ByCity(name string) ZoneSet {
+	return s.Filter(func(z Zone) bool {
+		return strings.EqualFold(z.City, name)
+	})
+}
+
+// Near filters zones within the specified radius of a location.
+func (s ZoneSet) Near(lat, lng float64, radiusKm float64) ZoneSet {
+	return s.Filter(func(z Zone) bool {
+		return z.IsNear(lat, lng, radiusKm)
+	})
+}
+
+// SortByDistance sorts zones by distance from a point (closest first).
+func (s ZoneSet) SortByDistance(lat, lng float64) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return haversineDistance(lat, lng, s[i].Latitude, s[i].Longitude) <
+			haversineDistance(lat, lng, s[j].Latitude, s[j].Longitude)
+	})
+}
+
+// First returns the first zone in the set, or an error if empty.
+func (s ZoneSet) First() (Zone, error) {
+	if len(s) == 0 {
+		return Zone{}, fmt.Errorf("no zones found")
+	}
+	return s[0], nil
+}
+
+// Names returns a slice of zone names from the set.
+func (s ZoneSet) Names() []string {
+	names := make([]string, len(s))
+	for i, zone := range s {
+		names[i] = zone.Name
+	}
+	return names
+}
+
+// Len returns the number of zones in the set.
+func (s ZoneSet) Len() int {
+	return len(s)
+}
+
+// AvailabilityZones returns the standard availability zones of the region.
+func (r Region) AvailabilityZones() ZoneSet {
+	zones := make(ZoneSet, len(r.Zones))
+	for i, name := range r.Zones {
+		zones[i] = Zone{
+			Name:      name,
+			Kind:      AvailabilityZone,
+			Provider:  r.Provider,
+			Region:    r.Code,
+			Group:     string(r.Code),
+			Country:   r.Country,
+			City:      r.City,
+			Continent: r.Continent,
+			Latitude:  r.Latitude,
+			Longitude: r.Longitude,
+		}
+	}
+	return zones
+}
+
+// ExtendedZones returns the local and wavelength zones parented by the region.
+func (r Region) ExtendedZones() ZoneSet {
+	return ExtendedZones().OnProvider(r.Provider).ByRegion(r.Code)
+}
+
+// ExtendedZones answers "where are the extended zones?" - returns all local and wavelength zones.
+func ExtendedZones() ZoneSet {
+	result := make(ZoneSet, len(extendedZones))
+	copy(result, extendedZones)
+	return result
+}
+
+// LocalZones answers "where are the local zones?" - returns all local zones.
+func LocalZones() ZoneSet {
+	return ExtendedZones().ByKind(LocalZone)
+}
+
+// WavelengthZones answers "where are the wavelength zones?" - returns all wavelength zones.
+func WavelengthZones() ZoneSet {
+	return ExtendedZones().ByKind(WavelengthZone)
+}
+
+// NearZones answers "where can I run compute near {location}?" - returns the
+// availability, local and wavelength zones within the radius of a location.
+func NearZones(lat, lng float64, radiusKm float64) ZoneSet {
+	zones := make(ZoneSet, 0)
+	for _, region := range commercialRegions().Near(lat, lng, radiusKm) {
+		zones = append(zones, region.AvailabilityZones()...)
+	}
+	return append(zones, ExtendedZones().Near(lat, lng, radiusKm)...)
+}
+
+// FindZone looks up a zone by name. Extended zones are matched first, then the
+// availability zones of every region. Where providers reuse a zone name, the
+// first match in catalog order is returned.
+func FindZone(name string) (Zone, error) {
+	if zone, ok := findZone("", name); ok {
+		return zone, nil
+	}
+	return Zone{}, fmt.Errorf("%w: %s", ErrZoneNotFound, name)
+}
+
+// findZone looks up a zone by name as FindZone does, restricted to a provider
+// unless provider is empty.
+func findZone(provider, name string) (Zone, bool) {
+	for _, zone := range extendedZones {
+		if zone.Name == name && (provider == "" || zone.Provider == provider) {
+			return zone, true
+		}
+	}
+	for _, region := range catalog {
+		if provider != "" && region.Provider != provider {
+			continue
+		}
+		for _, zone := range region.AvailabilityZones() {
+			if zone.Name == name {
+				return zone, true
+			}
+		}
+	}
+	return Zone{}, false
+}
+
+var extendedZones = ZoneSet{
+	// AWS Local Zones
+	{Name: "us-east-1-bos-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-bos-1", Country: "United States", City: "Boston", Continent: "North America", Latitude: 42.3601, Longitude: -71.0589, OptIn: true},
+	{Name: "us-east-1-nyc-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-nyc-1", Country: "United States", City: "New York", Continent: "North America", Latitude: 40.7128, Longitude: -74.006, OptIn: true},
+	{Name: "us-east-1-phl-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-phl-1", Country: "United States", City: "Philadelphia", Continent: "North America", Latitude: 39.9526, Longitude: -75.1652, OptIn: true},
+	{Name: "us-east-1-atl-2a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-atl-2", Country: "United States", City: "Atlanta", Continent: "North America", Latitude: 33.749, Longitude: -84.388, OptIn: true},
+	{Name: "us-east-1-mia-2a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-mia-2", Country: "United States", City: "Miami", Continent: "North America", Latitude: 25.7617, Longitude: -80.1918, OptIn: true},
+	{Name: "us-east-1-chi-2a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-chi-2", Country: "United States", City: "Chicago", Continent: "North America", Latitude: 41.8781, Longitude: -87.6298, OptIn: true},
+	{Name: "us-east-1-dfw-2a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-dfw-2", Country: "United States", City: "Dallas", Continent: "North America", Latitude: 32.7767, Longitude: -96.797, OptIn: true},
+	{Name: "us-east-1-iah-2a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-iah-2", Country: "United States", City: "Houston", Continent: "North America", Latitude: 29.7604, Longitude: -95.3698, OptIn: true},
+	{Name: "us-east-1-mci-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-mci-1", Country: "United States", City: "Kansas City", Continent: "North America", Latitude: 39.0997, Longitude: -94.5786, OptIn: true},
+	{Name: "us-east-1-msp-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-msp-1", Country: "United States", City: "Minneapolis", Continent: "North America", Latitude: 44.9778, Longitude: -93.265, OptIn: true},
+	{Name: "us-east-1-qro-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-qro-1", Country: "Mexico", City: "Querétaro", Continent: "North America", Latitude: 20.5888, Longitude: -100.3899, OptIn: true},
+	{Name: "us-east-1-lim-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-lim-1", Country: "Peru", City: "Lima", Continent: "South America", Latitude: -12.0464, Longitude: -77.0428, OptIn: true},
+	{Name: "us-east-1-scl-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-scl-1", Country: "Chile", City: "Santiago", Continent: "South America", Latitude: -33.4489, Longitude: -70.6693, OptIn: true},
+	{Name: "us-east-1-bue-1a", Kind: LocalZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-bue-1", Country: "Argentina", City: "Buenos Aires", Continent: "South America", Latitude: -34.6037, Longitude: -58.3816, OptIn: true},
+	{Name: "us-west-2-lax-1a", Kind: LocalZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-lax-1", Country: "United States", City: "Los Angeles", Continent: "North America", Latitude: 34.0522, Longitude: -118.2437, OptIn: true},
+	{Name: "us-west-2-lax-1b", Kind: LocalZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-lax-1", Country: "United States", City: "Los Angeles", Continent: "North America", Latitude: 34.0522, Longitude: -118.2437, OptIn: true},
+	{Name: "us-west-2-las-1a", Kind: LocalZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-las-1", Country: "United States", City: "Las Vegas", Continent: "North America", Latitude: 36.1699, Longitude: -115.1398, OptIn: true},
+	{Name: "us-west-2-den-1a", Kind: LocalZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-den-1", Country: "United States", City: "Denver", Continent: "North America", Latitude: 39.7392, Longitude: -104.9903, OptIn: true},
+	{Name: "us-west-2-phx-2a", Kind: LocalZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-phx-2", Country: "United States", City: "Phoenix", Continent: "North America", Latitude: 33.4484, Longitude: -112.074, OptIn: true},
+	{Name: "us-west-2-pdx-1a", Kind: LocalZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-pdx-1", Country: "United States", City: "Portland", Continent: "North America", Latitude: 45.5152, Longitude: -122.6784, OptIn: true},
+	{Name: "us-west-2-sea-1a", Kind: LocalZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-sea-1", Country: "United States", City: "Seattle", Continent: "North America", Latitude: 47.6062, Longitude: -122.3321, OptIn: true},
+	{Name: "eu-central-1-ham-1a", Kind: LocalZone, Provider: "aws", Region: "eu-central-1", Group: "eu-central-1-ham-1", Country: "Germany", City: "Hamburg", Continent: "Europe", Latitude: 53.5511, Longitude: 9.9937, OptIn: true},
+	{Name: "eu-central-1-waw-1a", Kind: LocalZone, Provider: "aws", Region: "eu-central-1", Group: "eu-central-1-waw-1", Country: "Poland", City: "Warsaw", Continent: "Europe", Latitude: 52.2297, Longitude: 21.0122, OptIn: true},
+	{Name: "eu-north-1-cph-1a", Kind: LocalZone, Provider: "aws", Region: "eu-north-1", Group: "eu-north-1-cph-1", Country: "Denmark", City: "Copenhagen", Continent: "Europe", Latitude: 55.6761, Longitude: 12.5683, OptIn: true},
+	{Name: "eu-north-1-hel-1a", Kind: LocalZone, Provider: "aws", Region: "eu-north-1", Group: "eu-north-1-hel-1", Country: "Finland", City: "Helsinki", Continent: "Europe", Latitude: 60.1699, Longitude: 24.9384, OptIn: true},
+	{Name: "ap-south-1-ccu-2a", Kind: LocalZone, Provider: "aws", Region: "ap-south-1", Group: "ap-south-1-ccu-2", Country: "India", City: "Kolkata", Continent: "Asia", Latitude: 22.5726, Longitude: 88.3639, OptIn: true},
+	{Name: "ap-south-1-del-2a", Kind: LocalZone, Provider: "aws", Region: "ap-south-1", Group: "ap-south-1-del-2", Country: "India", City: "Delhi", Continent: "Asia", Latitude: 28.7041, Longitude: 77.1025, OptIn: true},
+	{Name: "ap-southeast-1-bkk-1a", Kind: LocalZone, Provider: "aws", Region: "ap-southeast-1", Group: "ap-southeast-1-bkk-1", Country: "Thailand", City: "Bangkok", Continent: "Asia", Latitude: 13.7563, Longitude: 100.5018, OptIn: true},
+	{Name: "ap-southeast-1-mnl-1a", Kind: LocalZone, Provider: "aws", Region: "ap-southeast-1", Group: "ap-southeast-1-mnl-1", Country: "Philippines", City: "Manila", Continent: "Asia", Latitude: 14.5995, Longitude: 120.9842, OptIn: true},
+	{Name: "ap-northeast-1-tpe-1a", Kind: LocalZone, Provider: "aws", Region: "ap-northeast-1", Group: "ap-northeast-1-tpe-1", Country: "Taiwan", City: "Taipei", Continent: "Asia", Latitude: 25.033, Longitude: 121.5654, OptIn: true},
+	{Name: "ap-southeast-2-akl-1a", Kind: LocalZone, Provider: "aws", Region: "ap-southeast-2", Group: "ap-southeast-2-akl-1", Country: "New Zealand", City: "Auckland", Continent: "Oceania", Latitude: -36.8485, Longitude: 174.7633, OptIn: true},
+	{Name: "ap-southeast-2-per-1a", Kind: LocalZone, Provider: "aws", Region: "ap-southeast-2", Group: "ap-southeast-2-per-1", Country: "Australia", City: "Perth", Continent: "Oceania", Latitude: -31.9505, Longitude: 115.8605, OptIn: true},
+	{Name: "me-south-1-mct-1a", Kind: LocalZone, Provider: "aws", Region: "me-south-1", Group: "me-south-1-mct-1", Country: "Oman", City: "Muscat", Continent: "Asia", Latitude: 23.588, Longitude: 58.3829, OptIn: true},
+	{Name: "af-south-1-los-1a", Kind: LocalZone, Provider: "aws", Region: "af-south-1", Group: "af-south-1-los-1", Country: "Nigeria", City: "Lagos", Continent: "Africa", Latitude: 6.5244, Longitude: 3.3792, OptIn: true},
+	// AWS Wavelength Zones
+	{Name: "us-east-1-wl1-bos-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-wl1", Country: "United States", City: "Boston", Continent: "North America", Latitude: 42.3601, Longitude: -71.0589, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-east-1-wl1-nyc-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-wl1", Country: "United States", City: "New York", Continent: "North America", Latitude: 40.7128, Longitude: -74.006, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-east-1-wl1-atl-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-wl1", Country: "United States", City: "Atlanta", Continent: "North America", Latitude: 33.749, Longitude: -84.388, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-east-1-wl1-chi-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-wl1", Country: "United States", City: "Chicago", Continent: "North America", Latitude: 41.8781, Longitude: -87.6298, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-east-1-wl1-dfw-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-wl1", Country: "United States", City: "Dallas", Continent: "North America", Latitude: 32.7767, Longitude: -96.797, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-east-1-wl1-mia-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-east-1", Group: "us-east-1-wl1", Country: "United States", City: "Miami", Continent: "North America", Latitude: 25.7617, Longitude: -80.1918, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-west-2-wl1-sfo-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-wl1", Country: "United States", City: "San Francisco", Continent: "North America", Latitude: 37.7749, Longitude: -122.4194, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-west-2-wl1-las-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-wl1", Country: "United States", City: "Las Vegas", Continent: "North America", Latitude: 36.1699, Longitude: -115.1398, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-west-2-wl1-sea-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-wl1", Country: "United States", City: "Seattle", Continent: "North America", Latitude: 47.6062, Longitude: -122.3321, OptIn: true, Carrier: "Verizon"},
+	{Name: "us-west-2-wl1-den-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "us-west-2", Group: "us-west-2-wl1", Country: "United States", City: "Denver", Continent: "North America", Latitude: 39.7392, Longitude: -104.9903, OptIn: true, Carrier: "Verizon"},
+	{Name: "ca-central-1-wl1-yto-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "ca-central-1", Group: "ca-central-1-wl1", Country: "Canada", City: "Toronto", Continent: "North America", Latitude: 43.6532, Longitude: -79.3832, OptIn: true, Carrier: "Bell"},
+	{Name: "eu-west-2-wl1-lon-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "eu-west-2", Group: "eu-west-2-wl1", Country: "United Kingdom", City: "London", Continent: "Europe", Latitude: 51.5074, Longitude: -0.1278, OptIn: true, Carrier: "Vodafone"},
+	{Name: "eu-west-2-wl1-man-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "eu-west-2", Group: "eu-west-2-wl1", Country: "United Kingdom", City: "Manchester", Continent: "Europe", Latitude: 53.4808, Longitude: -2.2426, OptIn: true, Carrier: "Vodafone"},
+	{Name: "eu-central-1-wl1-ber-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "eu-central-1", Group: "eu-central-1-wl1", Country: "Germany", City: "Berlin", Continent: "Europe", Latitude: 52.52, Longitude: 13.405, OptIn: true, Carrier: "Vodafone"},
+	{Name: "eu-central-1-wl1-muc-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "eu-central-1", Group: "eu-central-1-wl1", Country: "Germany", City: "Munich", Continent: "Europe", Latitude: 48.1351, Longitude: 11.582, OptIn: true, Carrier: "Vodafone"},
+	{Name: "eu-central-1-wl1-dtm-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "eu-central-1", Group: "eu-central-1-wl1", Country: "Germany", City: "Dortmund", Continent: "Europe", Latitude: 51.5136, Longitude: 7.4653, OptIn: true, Carrier: "Vodafone"},
+	{Name: "ap-northeast-1-wl1-nrt-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "ap-northeast-1", Group: "ap-northeast-1-wl1", Country: "Japan", City: "Tokyo", Continent: "Asia", Latitude: 35.6762, Longitude: 139.6503, OptIn: true, Carrier: "KDDI"},
+	{Name: "ap-northeast-1-wl1-kix-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "ap-northeast-1", Group: "ap-northeast-1-wl1", Country: "Japan", City: "Osaka", Continent: "Asia", Latitude: 34.6937, Longitude: 135.5023, OptIn: true, Carrier: "KDDI"},
+	{Name: "ap-northeast-2-wl1-sel-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "ap-northeast-2", Group: "ap-northeast-2-wl1", Country: "South Korea", City: "Seoul", Continent: "Asia", Latitude: 37.5665, Longitude: 126.978, OptIn: true, Carrier: "SK Telecom"},
+	{Name: "ap-northeast-2-wl1-cjj-wlz-1", Kind: WavelengthZone, Provider: "aws", Region: "ap-northeast-2", Group: "ap-northeast-2-wl1", Country: "South Korea", City: "Daejeon", Continent: "Asia", Latitude: 36.3504, Longitude: 127.3845, OptIn: true, Carrier: "SK Telecom"},
+}
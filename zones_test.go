@@ -0,0 +1,91 @@
+package where
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestZoneKind_String(t *testing.T) {
+	tests := []struct {
+		kind     ZoneKind
+		expected string
+	}{
+		{AvailabilityZone, "availability-zone"},
+		{LocalZone, "local-zone"},
+		{WavelengthZone, "wavelength-zone"},
+		{ZoneKind(99), "unknown"},
+	}
+
+	for _, test := range tests {
+		if got := test.kind.String(); got != test.expected {
+			t.Errorf("ZoneKind(%d).String() = %q, want %q", test.kind, got, test.expected)
+		}
+	}
+}
+
+func TestExtendedZones(t *testing.T) {
+	for _, zone := range ExtendedZones() {
+		if _, err := zone.ParentRegion(); err != nil {
+			t.Errorf("zone %s has unknown parent %s: %v", zone.Name, zone.Region, err)
+			continue
+		}
+		if zone.Kind == AvailabilityZone {
+			t.Errorf("zone %s should be a local or wavelength zone", zone.Name)
+		}
+		if !zone.OptIn {
+			t.Errorf("zone %s should require opt-in", zone.Name)
+		}
+	}
+
+	region, err := Is("us-west-2").OnAWS()
+	if err != nil {
+		t.Fatalf("Is() failed: %v", err)
+	}
+	lax := region.ExtendedZones().ByKind(LocalZone).ByCity("Los Angeles")
+	if len(lax) != 2 {
+		t.Errorf("expected 2 Los Angeles local zones, got %v", lax.Names())
+	}
+	if len(WavelengthZones()) == 0 || len(LocalZones()) == 0 {
+		t.Error("expected both local and wavelength zones")
+	}
+}
+
+func TestNearZones(t *testing.T) {
+	// Boston has no region, but a local zone and a wavelength zone.
+	zones := NearZones(42.3601, -71.0589, 50)
+	if len(zones) == 0 {
+		t.Fatal("expected zones near Boston")
+	}
+	zones.SortByDistance(42.3601, -71.0589)
+	nearest, _ := zones.First()
+	if nearest.Region != "us-east-1" || nearest.Kind == AvailabilityZone {
+		t.Errorf("expected a us-east-1 extended zone nearest Boston, got %+v", nearest)
+	}
+
+	// Frankfurt has full regions; their availability zones are included.
+	if len(Proximity.Zones(50.1109, 8.6821, 50).ByKind(AvailabilityZone).ByRegion("eu-central-1")) == 0 {
+		t.Error("expected eu-central-1 availability zones near Frankfurt")
+	}
+}
+
+func TestFindZone(t *testing.T) {
+	zone, err := FindZone("us-east-1-bos-1a")
+	if err != nil {
+		t.Fatalf("FindZone() failed: %v", err)
+	}
+	if zone.Kind != LocalZone || zone.Group != "us-east-1-bos-1" {
+		t.Errorf("unexpected zone %+v", zone)
+	}
+
+	az, err := FindZone("eu-west-1b")
+	if err != nil {
+		t.Fatalf("FindZone() failed: %v", err)
+	}
+	if az.Kind != AvailabilityZone || az.Region != "eu-west-1" || az.Provider != ProviderAWS {
+		t.Errorf("unexpected zone %+v", az)
+	}
+
+	if _, err := FindZone("nowhere-1a"); !errors.Is(err, ErrZoneNotFound) {
+		t.Errorf("FindZone() should return ErrZoneNotFound, got %v", err)
+	}
+}